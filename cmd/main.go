@@ -28,7 +28,11 @@ func main() {
 	shutdown := make(chan os.Signal, 1)
 	signal.Notify(shutdown, syscall.SIGTERM, syscall.SIGINT)
 
-	<-shutdown
-
-	app.GracefulStop()
+	select {
+	case <-shutdown:
+		app.GracefulStop()
+	case <-app.Decommissioned():
+		app.GracefulStop()
+		log.Println("node decommissioned")
+	}
 }
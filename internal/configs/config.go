@@ -1,8 +1,10 @@
 package configs
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strconv"
 )
 
@@ -16,6 +18,35 @@ type Config struct {
 	serfBindAddress                    string
 	serfBindPort                       int
 	dockerClientAddress                string
+	appGCIntervalSeconds               int64
+	appGCRetentionSeconds              int64
+	appOperationWorkers                int
+	appOperationQueueSize              int
+	appOperationTimeoutSeconds         int64
+	appIndexResyncSeconds              int64
+	auditDirPath                       string
+	auditMaxFileBytes                  int64
+	auditMaxFiles                      int
+	defaultAppImage                    string
+	imagePullPolicy                    string
+	imagePullTimeoutSeconds            int64
+	registryAuthFilePath               string
+	registryServer                     string
+	registryUsername                   string
+	registryPassword                   string
+	heartbeatIntervalSeconds           int64
+	nodeLabels                         string
+	nodeLabelsFilePath                 string
+	nodeLabelsDirPath                  string
+	nodeLabelsReloadSeconds            int64
+	appRuntime                         string
+	processRuntimeDirPath              string
+	labelProviderTimeoutMilliseconds   int64
+	dataVolumePath                     string
+	systemReservedCPU                  float64
+	systemReservedMemoryGB             float64
+	systemReservedDiskGB               float64
+	namespaceQuotasFilePath            string
 }
 
 func (c *Config) NatsAddress() string {
@@ -30,6 +61,8 @@ func (c *Config) RegistrationReqTimeoutMilliseconds() int64 {
 	return c.registrationReqTimeoutMilliseconds
 }
 
+// MaxRegistrationRetries is the number of registration attempts, 0 makes a single
+// attempt and a negative number, e.g. -1, retries registration until it succeeds
 func (c *Config) MaxRegistrationRetries() int8 {
 	return c.maxRegistrationRetries
 }
@@ -54,6 +87,124 @@ func (c *Config) SerfBindPort() int {
 	return c.serfBindPort
 }
 
+func (c *Config) AppGCIntervalSeconds() int64 {
+	return c.appGCIntervalSeconds
+}
+
+func (c *Config) AppGCRetentionSeconds() int64 {
+	return c.appGCRetentionSeconds
+}
+
+func (c *Config) AppOperationWorkers() int {
+	return c.appOperationWorkers
+}
+
+func (c *Config) AppOperationQueueSize() int {
+	return c.appOperationQueueSize
+}
+
+func (c *Config) AppOperationTimeoutSeconds() int64 {
+	return c.appOperationTimeoutSeconds
+}
+
+func (c *Config) AppIndexResyncSeconds() int64 {
+	return c.appIndexResyncSeconds
+}
+
+func (c *Config) ImagePullTimeoutSeconds() int64 {
+	return c.imagePullTimeoutSeconds
+}
+
+func (c *Config) AuditDirPath() string {
+	return c.auditDirPath
+}
+
+func (c *Config) AuditMaxFileBytes() int64 {
+	return c.auditMaxFileBytes
+}
+
+func (c *Config) AuditMaxFiles() int {
+	return c.auditMaxFiles
+}
+
+func (c *Config) DefaultAppImage() string {
+	return c.defaultAppImage
+}
+
+func (c *Config) ImagePullPolicy() string {
+	return c.imagePullPolicy
+}
+
+func (c *Config) RegistryAuthFilePath() string {
+	return c.registryAuthFilePath
+}
+
+func (c *Config) RegistryServer() string {
+	return c.registryServer
+}
+
+func (c *Config) RegistryUsername() string {
+	return c.registryUsername
+}
+
+func (c *Config) RegistryPassword() string {
+	return c.registryPassword
+}
+
+func (c *Config) HeartbeatIntervalSeconds() int64 {
+	return c.heartbeatIntervalSeconds
+}
+
+func (c *Config) NodeLabels() string {
+	return c.nodeLabels
+}
+
+func (c *Config) NodeLabelsFilePath() string {
+	return c.nodeLabelsFilePath
+}
+
+func (c *Config) NodeLabelsDirPath() string {
+	return c.nodeLabelsDirPath
+}
+
+func (c *Config) NodeLabelsReloadSeconds() int64 {
+	return c.nodeLabelsReloadSeconds
+}
+
+func (c *Config) AppRuntime() string {
+	return c.appRuntime
+}
+
+func (c *Config) ProcessRuntimeDirPath() string {
+	return c.processRuntimeDirPath
+}
+
+func (c *Config) LabelProviderTimeoutMilliseconds() int64 {
+	return c.labelProviderTimeoutMilliseconds
+}
+
+func (c *Config) DataVolumePath() string {
+	return c.dataVolumePath
+}
+
+// SystemReservedCPU, SystemReservedMemoryGB and SystemReservedDiskGB are kept
+// for the system and star itself, apps can't reserve them
+func (c *Config) SystemReservedCPU() float64 {
+	return c.systemReservedCPU
+}
+
+func (c *Config) SystemReservedMemoryGB() float64 {
+	return c.systemReservedMemoryGB
+}
+
+func (c *Config) SystemReservedDiskGB() float64 {
+	return c.systemReservedDiskGB
+}
+
+func (c *Config) NamespaceQuotasFilePath() string {
+	return c.namespaceQuotasFilePath
+}
+
 func NewFromEnv() (*Config, error) {
 	registrationReqTimeoutMilliseconds, err := strconv.Atoi(os.Getenv("REGISTRATION_REQ_TIMEOUT_MILLISECONDS"))
 	if err != nil {
@@ -70,6 +221,114 @@ func NewFromEnv() (*Config, error) {
 		log.Println(err)
 		serfBindPort = 7946
 	}
+	appGCIntervalSeconds, err := strconv.Atoi(os.Getenv("APP_GC_INTERVAL_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		appGCIntervalSeconds = 300
+	}
+	if err := positiveInterval("APP_GC_INTERVAL_SECONDS", appGCIntervalSeconds); err != nil {
+		return nil, err
+	}
+	appGCRetentionSeconds, err := strconv.Atoi(os.Getenv("APP_GC_RETENTION_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		appGCRetentionSeconds = 3600
+	}
+	appOperationWorkers, err := strconv.Atoi(os.Getenv("APP_OPERATION_WORKERS"))
+	if err != nil {
+		log.Println(err)
+		appOperationWorkers = 8
+	}
+	appOperationQueueSize, err := strconv.Atoi(os.Getenv("APP_OPERATION_QUEUE_SIZE"))
+	if err != nil {
+		log.Println(err)
+		appOperationQueueSize = 256
+	}
+	appOperationTimeoutSeconds, err := strconv.Atoi(os.Getenv("APP_OPERATION_TIMEOUT_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		appOperationTimeoutSeconds = 60
+	}
+	appIndexResyncSeconds, err := strconv.Atoi(os.Getenv("APP_INDEX_RESYNC_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		appIndexResyncSeconds = 60
+	}
+	if err := positiveInterval("APP_INDEX_RESYNC_SECONDS", appIndexResyncSeconds); err != nil {
+		return nil, err
+	}
+	imagePullTimeoutSeconds, err := strconv.Atoi(os.Getenv("IMAGE_PULL_TIMEOUT_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		imagePullTimeoutSeconds = 600
+	}
+	auditDirPath := os.Getenv("AUDIT_DIR_PATH")
+	if auditDirPath == "" {
+		auditDirPath = filepath.Join(os.Getenv("NODE_ID_DIR_PATH"), "audit")
+	}
+	auditMaxFileBytes, err := strconv.ParseInt(os.Getenv("AUDIT_MAX_FILE_BYTES"), 10, 64)
+	if err != nil {
+		log.Println(err)
+		auditMaxFileBytes = 10 * 1024 * 1024
+	}
+	auditMaxFiles, err := strconv.Atoi(os.Getenv("AUDIT_MAX_FILES"))
+	if err != nil {
+		log.Println(err)
+		auditMaxFiles = 5
+	}
+	heartbeatIntervalSeconds, err := strconv.Atoi(os.Getenv("HEARTBEAT_INTERVAL_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		heartbeatIntervalSeconds = 30
+	}
+	if err := positiveInterval("HEARTBEAT_INTERVAL_SECONDS", heartbeatIntervalSeconds); err != nil {
+		return nil, err
+	}
+	nodeLabelsReloadSeconds, err := strconv.Atoi(os.Getenv("NODE_LABELS_RELOAD_SECONDS"))
+	if err != nil {
+		log.Println(err)
+		nodeLabelsReloadSeconds = 30
+	}
+	if err := positiveInterval("NODE_LABELS_RELOAD_SECONDS", nodeLabelsReloadSeconds); err != nil {
+		return nil, err
+	}
+	labelProviderTimeoutMilliseconds, err := strconv.Atoi(os.Getenv("LABEL_PROVIDER_TIMEOUT_MILLISECONDS"))
+	if err != nil {
+		log.Println(err)
+		labelProviderTimeoutMilliseconds = 5000
+	}
+	// the capacity of the volume star keeps its data on is reported, the node id directory by default
+	dataVolumePath := os.Getenv("DATA_VOLUME_PATH")
+	if dataVolumePath == "" {
+		dataVolumePath = os.Getenv("NODE_ID_DIR_PATH")
+	}
+	systemReservedCPU, err := strconv.ParseFloat(os.Getenv("SYSTEM_RESERVED_CPU"), 64)
+	if err != nil {
+		log.Println(err)
+		systemReservedCPU = 0
+	}
+	systemReservedMemoryGB, err := strconv.ParseFloat(os.Getenv("SYSTEM_RESERVED_MEMORY_GB"), 64)
+	if err != nil {
+		log.Println(err)
+		systemReservedMemoryGB = 0
+	}
+	systemReservedDiskGB, err := strconv.ParseFloat(os.Getenv("SYSTEM_RESERVED_DISK_GB"), 64)
+	if err != nil {
+		log.Println(err)
+		systemReservedDiskGB = 0
+	}
+	imagePullPolicy := os.Getenv("IMAGE_PULL_POLICY")
+	if imagePullPolicy == "" {
+		imagePullPolicy = "if-not-present"
+	}
+	appRuntime := os.Getenv("APP_RUNTIME")
+	if appRuntime == "" {
+		appRuntime = "docker"
+	}
+	processRuntimeDirPath := os.Getenv("PROCESS_RUNTIME_DIR_PATH")
+	if processRuntimeDirPath == "" {
+		processRuntimeDirPath = filepath.Join(os.Getenv("NODE_ID_DIR_PATH"), "apps")
+	}
 	return &Config{
 		natsAddress:                        os.Getenv("NATS_ADDRESS"),
 		registrationReqTimeoutMilliseconds: int64(registrationReqTimeoutMilliseconds),
@@ -80,5 +339,42 @@ func NewFromEnv() (*Config, error) {
 		serfBindAddress:                    os.Getenv("BIND_ADDRESS"),
 		serfBindPort:                       serfBindPort,
 		dockerClientAddress:                os.Getenv("DOCKER_CLIENT_ADDRESS"),
+		appGCIntervalSeconds:               int64(appGCIntervalSeconds),
+		appGCRetentionSeconds:              int64(appGCRetentionSeconds),
+		appOperationWorkers:                appOperationWorkers,
+		appOperationQueueSize:              appOperationQueueSize,
+		appOperationTimeoutSeconds:         int64(appOperationTimeoutSeconds),
+		appIndexResyncSeconds:              int64(appIndexResyncSeconds),
+		imagePullTimeoutSeconds:            int64(imagePullTimeoutSeconds),
+		auditDirPath:                       auditDirPath,
+		auditMaxFileBytes:                  auditMaxFileBytes,
+		auditMaxFiles:                      auditMaxFiles,
+		defaultAppImage:                    os.Getenv("DOCKER_CLIENT_IMAGE"),
+		imagePullPolicy:                    imagePullPolicy,
+		registryAuthFilePath:               os.Getenv("REGISTRY_AUTH_FILE"),
+		registryServer:                     os.Getenv("REGISTRY_SERVER"),
+		registryUsername:                   os.Getenv("REGISTRY_USERNAME"),
+		registryPassword:                   os.Getenv("REGISTRY_PASSWORD"),
+		heartbeatIntervalSeconds:           int64(heartbeatIntervalSeconds),
+		nodeLabels:                         os.Getenv("NODE_LABELS"),
+		nodeLabelsFilePath:                 os.Getenv("NODE_LABELS_FILE"),
+		nodeLabelsDirPath:                  os.Getenv("NODE_LABELS_DIR_PATH"),
+		nodeLabelsReloadSeconds:            int64(nodeLabelsReloadSeconds),
+		appRuntime:                         appRuntime,
+		processRuntimeDirPath:              processRuntimeDirPath,
+		labelProviderTimeoutMilliseconds:   int64(labelProviderTimeoutMilliseconds),
+		dataVolumePath:                     dataVolumePath,
+		systemReservedCPU:                  systemReservedCPU,
+		systemReservedMemoryGB:             systemReservedMemoryGB,
+		systemReservedDiskGB:               systemReservedDiskGB,
+		namespaceQuotasFilePath:            os.Getenv("NAMESPACE_QUOTAS_FILE"),
 	}, nil
 }
+
+// positiveInterval rejects intervals a ticker can't be created with
+func positiveInterval(name string, seconds int) error {
+	if seconds <= 0 {
+		return fmt.Errorf("%s must be a positive number of seconds, got %d", name, seconds)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type RestartPolicyMode string
+
+const (
+	RestartPolicyNever     RestartPolicyMode = "no"
+	RestartPolicyAlways    RestartPolicyMode = "always"
+	RestartPolicyOnFailure RestartPolicyMode = "on-failure"
+)
+
+// RestartPolicy MaxRetries of 0 means the app is restarted without limit
+type RestartPolicy struct {
+	Mode           RestartPolicyMode `json:"mode"`
+	MaxRetries     int64             `json:"maxRetries,omitempty"`
+	InitialBackoff time.Duration     `json:"initialBackoff,omitempty"`
+	MaxBackoff     time.Duration     `json:"maxBackoff,omitempty"`
+}
+
+// Restarts reports whether the policy brings the app back up in any case
+func (p RestartPolicy) Restarts() bool {
+	return p.Mode == RestartPolicyAlways || p.Mode == RestartPolicyOnFailure
+}
+
+// ShouldRestart reports whether a container that exited with exitCode
+// after the given number of consecutive failures should be started again
+func (p RestartPolicy) ShouldRestart(exitCode int64, failures int64) bool {
+	switch p.Mode {
+	case RestartPolicyAlways:
+	case RestartPolicyOnFailure:
+		if exitCode == 0 {
+			return false
+		}
+	default:
+		return false
+	}
+	return p.MaxRetries == 0 || failures < p.MaxRetries
+}
+
+// Backoff doubles the initial backoff for every consecutive failure, up to MaxBackoff
+func (p RestartPolicy) Backoff(failures int64) time.Duration {
+	backoff := p.InitialBackoff
+	for i := int64(0); i < failures && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, p.MaxBackoff)
+}
+
+type ImagePullPolicy string
+
+const (
+	ImagePullAlways       ImagePullPolicy = "always"
+	ImagePullIfNotPresent ImagePullPolicy = "if-not-present"
+	ImagePullNever        ImagePullPolicy = "never"
+)
+
+func (p ImagePullPolicy) Valid() bool {
+	return p == ImagePullAlways || p == ImagePullIfNotPresent || p == ImagePullNever
+}
+
+// ImageDefaults are used for start requests that don't give their own image or pull policy
+type ImageDefaults struct {
+	Image      string
+	PullPolicy ImagePullPolicy
+}
+
+// Resolve fills in the defaults, an empty image is left to the runtime to reject
+// since not every runtime runs images
+func (d ImageDefaults) Resolve(image, policy string) (string, ImagePullPolicy, error) {
+	if image == "" {
+		image = d.Image
+	}
+	if policy == "" {
+		return image, d.PullPolicy, nil
+	}
+	if !ImagePullPolicy(policy).Valid() {
+		return "", "", fmt.Errorf("invalid image pull policy: %s", policy)
+	}
+	return image, ImagePullPolicy(policy), nil
+}
+
+// AppPreStopHookLabel holds the JSON encoded default pre-stop hook of an app container,
+// it isn't reported among the app's labels
+const AppPreStopHookLabel = "star.pre_stop_hook"
+
+// AppRestartPolicyLabel holds the JSON encoded restart policy of an app container, so it is
+// supervised again after star restarts. It isn't reported among the app's labels.
+const AppRestartPolicyLabel = "star.restart_policy"
+
+// AppResourcesLabel holds the JSON encoded resources reserved for an app container,
+// it isn't reported among the app's labels
+const AppResourcesLabel = "star.resources"
+
+// AppNamespaceLabel holds the namespace the resources of an app container are reserved in,
+// it isn't reported among the app's labels
+const AppNamespaceLabel = "star.namespace"
+
+// AppSpecHashLabel holds the hash of the spec an app container was created from, a start
+// request reuses the container only when its spec has the same hash. It isn't reported
+// among the app's labels.
+const AppSpecHashLabel = "star.spec_hash"
+
+// PreStopHook either executes a command inside of the container or makes an HTTP request
+type PreStopHook struct {
+	Exec       []string      `json:"exec,omitempty"`
+	HTTPURL    string        `json:"httpUrl,omitempty"`
+	HTTPMethod string        `json:"httpMethod,omitempty"`
+	Timeout    time.Duration `json:"timeout"`
+}
+
+// StopOptions left empty fall back to the defaults given when the app was started
+type StopOptions struct {
+	Signal      string
+	GracePeriod time.Duration
+	PreStopHook *PreStopHook
+}
+
+const AppHealthUnhealthy = "unhealthy"
+
+// AppContainer is the indexed state of an app container, Running stays true while it is paused
+type AppContainer struct {
+	Id          string
+	Name        string
+	Labels      map[string]string
+	Image       string
+	ImageDigest string
+	State       string
+	Running     bool
+	Paused      bool
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	ExitCode    int64
+	// Health is empty for containers without a health check
+	Health      string
+	IPAddresses []string
+	Ports       []AppPort
+}
+
+// AppPort is a container port, HostPort is 0 if it isn't published
+type AppPort struct {
+	ContainerPort int
+	Protocol      string
+	HostIP        string
+	HostPort      int
+}
+
+// Healthy reports a running container whose health check, if it has one, isn't failing
+func (c AppContainer) Healthy() bool {
+	return c.Running && c.Health != AppHealthUnhealthy
+}
+
+// Available reports a healthy container that has been running for at least minReady
+func (c AppContainer) Available(minReady time.Duration, now time.Time) bool {
+	return c.Healthy() && now.Sub(c.StartedAt) >= minReady
+}
@@ -0,0 +1,49 @@
+package domain
+
+import "time"
+
+// AppRevisionLabel is set on every app container started on behalf of the rolling update service
+const AppRevisionLabel = "revision"
+
+type AppEventType int8
+
+const (
+	AppEventStarted AppEventType = iota
+	AppEventDied
+	AppEventOOMKilled
+	AppEventHealthStatusChanged
+	AppEventPaused
+	AppEventUnpaused
+	AppEventRemoved
+)
+
+func (t AppEventType) String() string {
+	switch t {
+	case AppEventStarted:
+		return "started"
+	case AppEventDied:
+		return "died"
+	case AppEventOOMKilled:
+		return "oom_killed"
+	case AppEventHealthStatusChanged:
+		return "health_status_changed"
+	case AppEventPaused:
+		return "paused"
+	case AppEventUnpaused:
+		return "unpaused"
+	case AppEventRemoved:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+type AppEvent struct {
+	Type         AppEventType
+	ContainerId  string
+	Name         string
+	Labels       map[string]string
+	ExitCode     int64
+	HealthStatus string
+	Time         time.Time
+}
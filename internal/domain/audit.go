@@ -0,0 +1,47 @@
+package domain
+
+import "time"
+
+const (
+	AuditKindAppOperation = "app_operation"
+	AuditKindConfig       = "config"
+	AuditKindNode         = "node"
+)
+
+// AuditEntry records a single app operation, config change or node operation and its outcome. Subject is
+// the NATS subject the request came in on, or the name of the sending service when its
+// client library doesn't expose the subject. Parameters holds the JSON encoded request.
+type AuditEntry struct {
+	Time          time.Time `json:"time"`
+	Kind          string    `json:"kind"`
+	Operation     string    `json:"operation"`
+	Name          string    `json:"name"`
+	RequestId     string    `json:"requestId,omitempty"`
+	Subject       string    `json:"subject,omitempty"`
+	Parameters    string    `json:"parameters,omitempty"`
+	Success       bool      `json:"success"`
+	ErrorMessages []string  `json:"errorMessages,omitempty"`
+}
+
+// AuditQuery zero values match everything, Limit keeps the most recent entries
+type AuditQuery struct {
+	From  time.Time
+	To    time.Time
+	Name  string
+	Limit int
+}
+
+func (q AuditQuery) Matches(entry AuditEntry) bool {
+	if !q.From.IsZero() && entry.Time.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && entry.Time.After(q.To) {
+		return false
+	}
+	return q.Name == "" || entry.Name == q.Name
+}
+
+type AuditStore interface {
+	Append(entry AuditEntry) *Error
+	Query(query AuditQuery) ([]AuditEntry, *Error)
+}
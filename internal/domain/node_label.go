@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const maxNodeLabelNameLength = 63
+
+var (
+	nodeLabelNamePattern   = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]*[a-zA-Z0-9])?$`)
+	nodeLabelPrefixPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)*$`)
+)
+
+// ValidateNodeLabelKey accepts keys of the form [prefix/]name, where the optional prefix is a
+// DNS subdomain and the name is up to 63 alphanumerics, '-', '_' and '.', starting and
+// ending with an alphanumeric, e.g. zone or example.com/rack
+func ValidateNodeLabelKey(key string) error {
+	name := key
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		if len(prefix) > 253 || !nodeLabelPrefixPattern.MatchString(prefix) {
+			return fmt.Errorf("invalid node label key %q: prefix must be a DNS subdomain", key)
+		}
+		name = rest
+	}
+	if len(name) > maxNodeLabelNameLength || !nodeLabelNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid node label key %q: name must be up to %d alphanumerics, '-', '_' or '.', starting and ending with an alphanumeric", key, maxNodeLabelNameLength)
+	}
+	return nil
+}
@@ -8,4 +8,19 @@ type NodeIdStore interface {
 	Get() (*NodeId, error)
 	Put(nodeId NodeId) error
 	PutClusterId(clusterId string) error
+	// Delete removes the node and cluster ids, the node registers as a new one afterwards
+	Delete() error
+}
+
+// NodeSigner signs the messages the node publishes, so the control plane can verify they come from it
+type NodeSigner interface {
+	PublicKey() string
+	Sign(data []byte) ([]byte, error)
+}
+
+type NodeKeyStore interface {
+	// Load returns the node keypair, a new one is generated and persisted on first start
+	Load() (NodeSigner, error)
+	// Delete removes the keypair, the node gets a new one once it registers again
+	Delete() error
 }
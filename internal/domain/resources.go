@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resources are cpu in cores, memory and disk in GB, the units of the cpu, mem and disk
+// resources the node registers with
+type Resources struct {
+	CPU      float64 `json:"cpu,omitempty"`
+	MemoryGB float64 `json:"memoryGB,omitempty"`
+	DiskGB   float64 `json:"diskGB,omitempty"`
+}
+
+func (r Resources) IsZero() bool {
+	return r.CPU == 0 && r.MemoryGB == 0 && r.DiskGB == 0
+}
+
+func (r Resources) Add(other Resources) Resources {
+	return Resources{
+		CPU:      r.CPU + other.CPU,
+		MemoryGB: r.MemoryGB + other.MemoryGB,
+		DiskGB:   r.DiskGB + other.DiskGB,
+	}
+}
+
+// Sub doesn't go below zero, a node can't have less than nothing left
+func (r Resources) Sub(other Resources) Resources {
+	return Resources{
+		CPU:      max(r.CPU-other.CPU, 0),
+		MemoryGB: max(r.MemoryGB-other.MemoryGB, 0),
+		DiskGB:   max(r.DiskGB-other.DiskGB, 0),
+	}
+}
+
+// Valid rejects negative amounts
+func (r Resources) Valid() bool {
+	return r.CPU >= 0 && r.MemoryGB >= 0 && r.DiskGB >= 0
+}
+
+const (
+	AdmissionInsufficientCapacity   = "insufficient_capacity"
+	AdmissionNamespaceQuotaExceeded = "namespace_quota_exceeded"
+)
+
+// AdmissionError rejects an app whose requested resources exceed what is allocatable on the
+// node or what is left of the quota of its namespace, Reason is one of the Admission constants
+type AdmissionError struct {
+	Reason    string
+	Namespace string
+	Exceeded  []ExceededResource
+}
+
+// ExceededResource Resource is cpu, memoryGB or diskGB
+type ExceededResource struct {
+	Resource  string
+	Requested float64
+	Available float64
+}
+
+func (e *AdmissionError) Error() string {
+	exceeded := make([]string, 0, len(e.Exceeded))
+	for _, resource := range e.Exceeded {
+		exceeded = append(exceeded, fmt.Sprintf("%s requested %g, available %g", resource.Resource, resource.Requested, resource.Available))
+	}
+	if e.Reason == AdmissionNamespaceQuotaExceeded {
+		return fmt.Sprintf("Admission rejected, quota of namespace %s exceeded: %s", e.Namespace, strings.Join(exceeded, ", "))
+	}
+	return fmt.Sprintf("Admission rejected, insufficient node capacity: %s", strings.Join(exceeded, ", "))
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"time"
+)
+
+var (
+	ErrAppNotFound = errors.New("app not found")
+	ErrAppConflict = errors.New("app is in a conflicting state")
+)
+
+// AppSpec describes an app container to create, an empty Command runs the runtime's idle command
+type AppSpec struct {
+	Name       string
+	Image      string
+	Command    []string
+	Env        []string
+	WorkingDir string
+	Labels     map[string]string
+	// StopSignal and StopGracePeriod are used when a stop request doesn't give its own
+	StopSignal      string
+	StopGracePeriod time.Duration
+	// Resources are enforced as limits by runtimes that can, the process runtime can't
+	Resources Resources
+	// Namespace is the one the resources are reserved in, it counts against its quota
+	Namespace string
+}
+
+// Hash covers the whole spec except for the AppSpecHashLabel, the labels image
+// LABELs are merged into by the runtime don't change it
+func (s AppSpec) Hash() string {
+	s.Labels = maps.Clone(s.Labels)
+	delete(s.Labels, AppSpecHashLabel)
+	// a struct of plain fields and a map with sorted keys always encodes the same
+	data, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// AppLogsOptions TailLines of 0 returns the whole log, a zero Since doesn't filter by time
+type AppLogsOptions struct {
+	TailLines int64
+	Since     time.Time
+	Follow    bool
+}
+
+type AppStats struct {
+	CPUPercent       float64
+	MemoryUsageBytes uint64
+	MemoryLimitBytes uint64
+	NetworkRxBytes   uint64
+	NetworkTxBytes   uint64
+	BlockReadBytes   uint64
+	BlockWriteBytes  uint64
+}
+
+// AppRuntime runs app containers. Operations on an app that doesn't exist return an error
+// wrapping ErrAppNotFound, Stop and Remove of such an app included.
+type AppRuntime interface {
+	// Name and Version identify the runtime in the node's labels, Version can be empty
+	Name() string
+	Version(ctx context.Context) (string, error)
+	EnsureImage(ctx context.Context, image string, policy ImagePullPolicy) error
+	Create(ctx context.Context, spec AppSpec) (string, error)
+	Start(ctx context.Context, name string) error
+	// Stop sends only the signal and uses only the grace period of the options
+	Stop(ctx context.Context, name string, options StopOptions) error
+	Restart(ctx context.Context, name string, options StopOptions) error
+	Pause(ctx context.Context, name string) error
+	Unpause(ctx context.Context, name string) error
+	Remove(ctx context.Context, name string) error
+	Inspect(ctx context.Context, name string) (AppContainer, error)
+	// List returns every app container, stopped ones included
+	List(ctx context.Context) ([]AppContainer, error)
+	// Exec runs the command in the app's environment and returns its exit code
+	Exec(ctx context.Context, name string, cmd []string) (int64, error)
+	Logs(ctx context.Context, name string, options AppLogsOptions, stdout, stderr io.Writer) error
+	Stats(ctx context.Context, name string) (AppStats, error)
+	// Events streams app events from since until ctx is done or the stream fails
+	Events(ctx context.Context, since time.Time) (<-chan AppEvent, <-chan error)
+}
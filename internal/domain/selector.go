@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type SelectorOperator string
+
+const (
+	SelectorOpIn        SelectorOperator = "in"
+	SelectorOpNotIn     SelectorOperator = "notin"
+	SelectorOpNotEquals SelectorOperator = "!="
+	SelectorOpExists    SelectorOperator = "exists"
+	SelectorOpAbsent    SelectorOperator = "absent"
+)
+
+type SelectorRequirement struct {
+	Key      string
+	Operator SelectorOperator
+	Values   []string
+}
+
+func (r SelectorRequirement) Validate() error {
+	if r.Key == "" {
+		return fmt.Errorf("selector requirement without a key")
+	}
+	switch r.Operator {
+	case SelectorOpIn, SelectorOpNotIn:
+		if len(r.Values) == 0 {
+			return fmt.Errorf("selector requirement %s %s needs at least one value", r.Key, r.Operator)
+		}
+	case SelectorOpNotEquals:
+		if len(r.Values) != 1 {
+			return fmt.Errorf("selector requirement %s %s needs exactly one value", r.Key, r.Operator)
+		}
+	case SelectorOpExists, SelectorOpAbsent:
+		if len(r.Values) != 0 {
+			return fmt.Errorf("selector requirement %s %s takes no values", r.Key, r.Operator)
+		}
+	default:
+		return fmt.Errorf("unknown selector operator: %s", r.Operator)
+	}
+	return nil
+}
+
+// Matches treats a missing label as not equal to any value, so notin and != match it.
+// An invalid requirement doesn't match anything.
+func (r SelectorRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case SelectorOpIn:
+		return ok && slices.Contains(r.Values, value)
+	case SelectorOpNotIn:
+		return !ok || !slices.Contains(r.Values, value)
+	case SelectorOpNotEquals:
+		if len(r.Values) != 1 {
+			return false
+		}
+		return !ok || value != r.Values[0]
+	case SelectorOpExists:
+		return ok
+	case SelectorOpAbsent:
+		return !ok
+	}
+	return false
+}
+
+func (r SelectorRequirement) String() string {
+	switch r.Operator {
+	case SelectorOpExists:
+		return r.Key
+	case SelectorOpAbsent:
+		return "!" + r.Key
+	case SelectorOpNotEquals:
+		// an invalid requirement is formatted like the others, so it can be logged
+		if len(r.Values) == 1 {
+			return r.Key + "!=" + r.Values[0]
+		}
+	}
+	return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ","))
+}
+
+// LabelSelector matches labels that have all of MatchLabels and meet all of the Requirements
+type LabelSelector struct {
+	MatchLabels  map[string]string
+	Requirements []SelectorRequirement
+}
+
+func (s LabelSelector) Validate() error {
+	for _, requirement := range s.Requirements {
+		if err := requirement.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for key, value := range s.MatchLabels {
+		if labelValue, ok := labels[key]; !ok || labelValue != value {
+			return false
+		}
+	}
+	for _, requirement := range s.Requirements {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Constrains reports whether any of the requirements is on the given key
+func (s LabelSelector) Constrains(key string) bool {
+	return slices.ContainsFunc(s.Requirements, func(requirement SelectorRequirement) bool {
+		return requirement.Key == key
+	})
+}
+
+func (s LabelSelector) String() string {
+	terms := make([]string, 0, len(s.MatchLabels)+len(s.Requirements))
+	for key, value := range s.MatchLabels {
+		terms = append(terms, key+"="+value)
+	}
+	sort.Strings(terms)
+	for _, requirement := range s.Requirements {
+		terms = append(terms, requirement.String())
+	}
+	return strings.Join(terms, ",")
+}
@@ -0,0 +1,100 @@
+package domain
+
+import "testing"
+
+func TestSelectorRequirementValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		requirement SelectorRequirement
+		valid       bool
+	}{
+		{"in with values", SelectorRequirement{Key: "tier", Operator: SelectorOpIn, Values: []string{"web", "db"}}, true},
+		{"in without values", SelectorRequirement{Key: "tier", Operator: SelectorOpIn}, false},
+		{"notin with values", SelectorRequirement{Key: "tier", Operator: SelectorOpNotIn, Values: []string{"web"}}, true},
+		{"notin without values", SelectorRequirement{Key: "tier", Operator: SelectorOpNotIn}, false},
+		{"not equals with a value", SelectorRequirement{Key: "tier", Operator: SelectorOpNotEquals, Values: []string{"web"}}, true},
+		{"not equals without a value", SelectorRequirement{Key: "tier", Operator: SelectorOpNotEquals}, false},
+		{"not equals with two values", SelectorRequirement{Key: "tier", Operator: SelectorOpNotEquals, Values: []string{"web", "db"}}, false},
+		{"exists", SelectorRequirement{Key: "tier", Operator: SelectorOpExists}, true},
+		{"exists with a value", SelectorRequirement{Key: "tier", Operator: SelectorOpExists, Values: []string{"web"}}, false},
+		{"absent", SelectorRequirement{Key: "tier", Operator: SelectorOpAbsent}, true},
+		{"absent with a value", SelectorRequirement{Key: "tier", Operator: SelectorOpAbsent, Values: []string{"web"}}, false},
+		{"without a key", SelectorRequirement{Operator: SelectorOpExists}, false},
+		{"unknown operator", SelectorRequirement{Key: "tier", Operator: "like", Values: []string{"web"}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.requirement.Validate()
+			if valid := err == nil; valid != test.valid {
+				t.Fatalf("Validate() = %v, want valid %v", err, test.valid)
+			}
+		})
+	}
+}
+
+func TestSelectorRequirementMatches(t *testing.T) {
+	labels := map[string]string{"tier": "web", "revision": "v2"}
+	tests := []struct {
+		name        string
+		requirement SelectorRequirement
+		matches     bool
+	}{
+		{"in containing the value", SelectorRequirement{Key: "tier", Operator: SelectorOpIn, Values: []string{"db", "web"}}, true},
+		{"in without the value", SelectorRequirement{Key: "tier", Operator: SelectorOpIn, Values: []string{"db"}}, false},
+		{"in on a missing label", SelectorRequirement{Key: "zone", Operator: SelectorOpIn, Values: []string{"a"}}, false},
+		{"notin containing the value", SelectorRequirement{Key: "tier", Operator: SelectorOpNotIn, Values: []string{"web"}}, false},
+		{"notin without the value", SelectorRequirement{Key: "tier", Operator: SelectorOpNotIn, Values: []string{"db"}}, true},
+		{"notin on a missing label", SelectorRequirement{Key: "zone", Operator: SelectorOpNotIn, Values: []string{"a"}}, true},
+		{"not equals the value", SelectorRequirement{Key: "revision", Operator: SelectorOpNotEquals, Values: []string{"v2"}}, false},
+		{"not equals another value", SelectorRequirement{Key: "revision", Operator: SelectorOpNotEquals, Values: []string{"v1"}}, true},
+		{"not equals on a missing label", SelectorRequirement{Key: "zone", Operator: SelectorOpNotEquals, Values: []string{"a"}}, true},
+		{"not equals without a value", SelectorRequirement{Key: "revision", Operator: SelectorOpNotEquals}, false},
+		{"exists", SelectorRequirement{Key: "tier", Operator: SelectorOpExists}, true},
+		{"exists on a missing label", SelectorRequirement{Key: "zone", Operator: SelectorOpExists}, false},
+		{"absent", SelectorRequirement{Key: "tier", Operator: SelectorOpAbsent}, false},
+		{"absent on a missing label", SelectorRequirement{Key: "zone", Operator: SelectorOpAbsent}, true},
+		{"unknown operator", SelectorRequirement{Key: "tier", Operator: "like", Values: []string{"web"}}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if matches := test.requirement.Matches(labels); matches != test.matches {
+				t.Fatalf("Matches() = %v, want %v", matches, test.matches)
+			}
+		})
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{"tier": "web", "revision": "v2"}
+	tests := []struct {
+		name     string
+		selector LabelSelector
+		matches  bool
+	}{
+		{"empty", LabelSelector{}, true},
+		{"match labels", LabelSelector{MatchLabels: map[string]string{"tier": "web"}}, true},
+		{"match labels with another value", LabelSelector{MatchLabels: map[string]string{"tier": "db"}}, false},
+		{"match labels and requirements", LabelSelector{
+			MatchLabels:  map[string]string{"tier": "web"},
+			Requirements: []SelectorRequirement{{Key: "revision", Operator: SelectorOpNotEquals, Values: []string{"v1"}}},
+		}, true},
+		{"failing requirement", LabelSelector{
+			MatchLabels:  map[string]string{"tier": "web"},
+			Requirements: []SelectorRequirement{{Key: "revision", Operator: SelectorOpIn, Values: []string{"v1"}}},
+		}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if matches := test.selector.Matches(labels); matches != test.matches {
+				t.Fatalf("Matches() = %v, want %v", matches, test.matches)
+			}
+		})
+	}
+}
+
+func TestSelectorRequirementStringWithoutValues(t *testing.T) {
+	requirement := SelectorRequirement{Key: "revision", Operator: SelectorOpNotEquals}
+	if got, want := requirement.String(), "revision != ()"; got != want {
+		t.Fatalf("String() = %q, want %q", got, want)
+	}
+}
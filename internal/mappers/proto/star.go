@@ -1,11 +1,22 @@
 package proto
 
 import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
 	configapi "github.com/c12s/kuiper/pkg/api"
 	"github.com/c12s/star/internal/domain"
 	"github.com/c12s/star/pkg/api"
 )
 
+const (
+	defaultInitialRestartBackoff = 10 * time.Second
+	defaultMaxRestartBackoff     = 5 * time.Minute
+	defaultPreStopHookTimeout    = 30 * time.Second
+)
+
 func ApplyConfigGroupCommandToDomain(config *configapi.ConfigGroup, namespace string) (*domain.ConfigGroup, error) {
 	resp := &domain.ConfigGroup{
 		ConfigBase: domain.ConfigBase{
@@ -78,3 +89,203 @@ func StandaloneConfigFromDomain(domainConfig domain.StandaloneConfig) (*api.Node
 	}
 	return config, nil
 }
+
+func AppEventFromDomain(nodeId string, event domain.AppEvent) *api.AppEvent {
+	resp := &api.AppEvent{
+		NodeId:       nodeId,
+		ContainerId:  event.ContainerId,
+		Name:         event.Name,
+		Labels:       event.Labels,
+		ExitCode:     event.ExitCode,
+		HealthStatus: event.HealthStatus,
+		Timestamp:    event.Time.UnixNano(),
+	}
+	switch event.Type {
+	case domain.AppEventStarted:
+		resp.Type = api.AppEventType_APP_STARTED
+	case domain.AppEventDied:
+		resp.Type = api.AppEventType_APP_DIED
+	case domain.AppEventOOMKilled:
+		resp.Type = api.AppEventType_APP_OOM_KILLED
+	case domain.AppEventHealthStatusChanged:
+		resp.Type = api.AppEventType_APP_HEALTH_STATUS_CHANGED
+	case domain.AppEventPaused:
+		resp.Type = api.AppEventType_APP_PAUSED
+	case domain.AppEventUnpaused:
+		resp.Type = api.AppEventType_APP_UNPAUSED
+	case domain.AppEventRemoved:
+		resp.Type = api.AppEventType_APP_REMOVED
+	default:
+		resp.Type = api.AppEventType_APP_EVENT_UNKNOWN
+	}
+	return resp
+}
+
+// RestartPolicyToDomain rejects unknown modes, an empty mode is the same as "no"
+func RestartPolicyToDomain(policy *api.RestartPolicy) (domain.RestartPolicy, error) {
+	resp := domain.RestartPolicy{
+		Mode:           domain.RestartPolicyNever,
+		InitialBackoff: defaultInitialRestartBackoff,
+		MaxBackoff:     defaultMaxRestartBackoff,
+	}
+	if policy == nil {
+		return resp, nil
+	}
+	switch domain.RestartPolicyMode(policy.Mode) {
+	case domain.RestartPolicyAlways, domain.RestartPolicyOnFailure:
+		resp.Mode = domain.RestartPolicyMode(policy.Mode)
+	case "", domain.RestartPolicyNever:
+	default:
+		return resp, fmt.Errorf("invalid restart policy: unknown mode %q", policy.Mode)
+	}
+	resp.MaxRetries = max(policy.MaxRetries, 0)
+	if policy.InitialBackoffSeconds > 0 {
+		resp.InitialBackoff = time.Duration(policy.InitialBackoffSeconds) * time.Second
+	}
+	if policy.MaxBackoffSeconds > 0 {
+		resp.MaxBackoff = time.Duration(policy.MaxBackoffSeconds) * time.Second
+	}
+	resp.MaxBackoff = max(resp.MaxBackoff, resp.InitialBackoff)
+	return resp, nil
+}
+
+// PreStopHookToDomain returns nil if the hook has neither a command nor a URL
+func PreStopHookToDomain(hook *api.PreStopHook) *domain.PreStopHook {
+	if hook == nil || (len(hook.Exec) == 0 && hook.HttpUrl == "") {
+		return nil
+	}
+	resp := &domain.PreStopHook{
+		Exec:       hook.Exec,
+		HTTPURL:    hook.HttpUrl,
+		HTTPMethod: hook.HttpMethod,
+		Timeout:    defaultPreStopHookTimeout,
+	}
+	if resp.HTTPMethod == "" {
+		resp.HTTPMethod = http.MethodGet
+	}
+	if hook.TimeoutSeconds > 0 {
+		resp.Timeout = time.Duration(hook.TimeoutSeconds) * time.Second
+	}
+	return resp
+}
+
+func LabelSelectorToDomain(selectorLabels map[string]string, requirements []*api.SelectorRequirement) domain.LabelSelector {
+	resp := domain.LabelSelector{
+		MatchLabels:  selectorLabels,
+		Requirements: make([]domain.SelectorRequirement, 0, len(requirements)),
+	}
+	for _, requirement := range requirements {
+		resp.Requirements = append(resp.Requirements, domain.SelectorRequirement{
+			Key:      requirement.Key,
+			Operator: domain.SelectorOperator(strings.ToLower(requirement.Operator)),
+			Values:   requirement.Values,
+		})
+	}
+	return resp
+}
+
+func AuditQueryToDomain(req *api.QueryAuditLogReq) domain.AuditQuery {
+	query := domain.AuditQuery{
+		Name:  req.Name,
+		Limit: int(max(req.Limit, 0)),
+	}
+	if req.FromTimestamp > 0 {
+		query.From = time.Unix(0, req.FromTimestamp)
+	}
+	if req.ToTimestamp > 0 {
+		query.To = time.Unix(0, req.ToTimestamp)
+	}
+	return query
+}
+
+func AuditEntryFromDomain(entry domain.AuditEntry) *api.AuditEntry {
+	return &api.AuditEntry{
+		Timestamp:     entry.Time.UnixNano(),
+		Kind:          entry.Kind,
+		Operation:     entry.Operation,
+		Name:          entry.Name,
+		RequestId:     entry.RequestId,
+		Subject:       entry.Subject,
+		Parameters:    entry.Parameters,
+		Success:       entry.Success,
+		ErrorMessages: entry.ErrorMessages,
+	}
+}
+
+func AppFromDomain(app domain.AppContainer, restartCount int64) *api.NodeApp {
+	resp := &api.NodeApp{
+		Name:           app.Name,
+		SelectorLabels: app.Labels,
+		RestartCount:   restartCount,
+		ContainerId:    app.Id,
+		ImageDigest:    app.ImageDigest,
+		State:          app.State,
+		ExitCode:       app.ExitCode,
+		IpAddresses:    app.IPAddresses,
+		Ports:          make([]*api.AppPort, 0, len(app.Ports)),
+	}
+	if !app.StartedAt.IsZero() {
+		resp.StartedAt = app.StartedAt.UnixNano()
+	}
+	if !app.FinishedAt.IsZero() {
+		resp.FinishedAt = app.FinishedAt.UnixNano()
+	}
+	for _, port := range app.Ports {
+		resp.Ports = append(resp.Ports, &api.AppPort{
+			ContainerPort: int64(port.ContainerPort),
+			Protocol:      port.Protocol,
+			HostIp:        port.HostIP,
+			HostPort:      int64(port.HostPort),
+		})
+	}
+	return resp
+}
+
+func AppStatsFromDomain(name string, stats domain.AppStats, restartCount int64) *api.AppStats {
+	return &api.AppStats{
+		Name:             name,
+		CpuPercent:       stats.CPUPercent,
+		MemoryUsageBytes: stats.MemoryUsageBytes,
+		MemoryLimitBytes: stats.MemoryLimitBytes,
+		NetworkRxBytes:   stats.NetworkRxBytes,
+		NetworkTxBytes:   stats.NetworkTxBytes,
+		BlockReadBytes:   stats.BlockReadBytes,
+		BlockWriteBytes:  stats.BlockWriteBytes,
+		RestartCount:     restartCount,
+	}
+}
+
+func ResourcesToDomain(resources *api.AppResources) domain.Resources {
+	if resources == nil {
+		return domain.Resources{}
+	}
+	return domain.Resources{
+		CPU:      resources.Cpu,
+		MemoryGB: resources.MemoryGB,
+		DiskGB:   resources.DiskGB,
+	}
+}
+
+func ResourcesFromDomain(resources domain.Resources) *api.AppResources {
+	return &api.AppResources{
+		Cpu:      resources.CPU,
+		MemoryGB: resources.MemoryGB,
+		DiskGB:   resources.DiskGB,
+	}
+}
+
+func AdmissionRejectionFromDomain(err *domain.AdmissionError) *api.AdmissionRejection {
+	exceeded := make([]*api.ExceededResource, 0, len(err.Exceeded))
+	for _, resource := range err.Exceeded {
+		exceeded = append(exceeded, &api.ExceededResource{
+			Resource:  resource.Resource,
+			Requested: resource.Requested,
+			Available: resource.Available,
+		})
+	}
+	return &api.AdmissionRejection{
+		Reason:    err.Reason,
+		Namespace: err.Namespace,
+		Exceeded:  exceeded,
+	}
+}
@@ -7,22 +7,28 @@ import (
 	"time"
 
 	meridianapi "github.com/c12s/meridian/pkg/api"
+	"github.com/c12s/star/internal/domain"
 	"github.com/c12s/star/internal/services"
+	"github.com/nats-io/nats.go"
 )
 
 type AppConfigAsyncServer struct {
 	client *meridianapi.MeridianAsyncClient
 	serf   *services.SerfAgent
+	audit  domain.AuditStore
+	acker  configAcker
 	nodeId string
 }
 
-func NewAppConfigAsyncServer(client *meridianapi.MeridianAsyncClient, serf *services.SerfAgent, nodeId string) (*AppConfigAsyncServer, error) {
+func NewAppConfigAsyncServer(client *meridianapi.MeridianAsyncClient, serf *services.SerfAgent, audit domain.AuditStore, conn *nats.Conn, signer domain.NodeSigner, nodeId string) (*AppConfigAsyncServer, error) {
 	if client == nil {
 		return nil, errors.New("client is nil while initializing app config async server")
 	}
 	return &AppConfigAsyncServer{
 		client: client,
 		serf:   serf,
+		audit:  audit,
+		acker:  configAcker{conn: conn, signer: signer, nodeId: nodeId},
 		nodeId: nodeId,
 	}, nil
 }
@@ -35,7 +41,21 @@ func (c *AppConfigAsyncServer) Serve() {
 		}
 		log.Println(cmd)
 		eventName := fmt.Sprintf("app_config-%s-%v", c.nodeId, time.Now().Unix())
-		return c.serf.TriggerUserEvent(eventName, cmd, true)
+		err := c.serf.TriggerUserEvent(eventName, cmd, true)
+		entry := domain.AuditEntry{
+			Kind:       domain.AuditKindConfig,
+			Operation:  "app_config",
+			Name:       appName,
+			Subject:    "meridian",
+			Parameters: cmd,
+			Success:    err == nil,
+		}
+		if err != nil {
+			entry.ErrorMessages = []string{err.Error()}
+		}
+		appendAudit(c.audit, entry)
+		c.acker.ack("app_config", appName, err)
+		return err
 	})
 	if err != nil {
 		log.Println(err)
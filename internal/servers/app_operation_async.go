@@ -5,68 +5,79 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"os"
+	"maps"
 	"strings"
+	"sync/atomic"
 	"time"
 
-	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/api/types/filters"
-	"github.com/docker/docker/client"
+	"github.com/c12s/star/internal/domain"
+	proto_mapper "github.com/c12s/star/internal/mappers/proto"
+	"github.com/c12s/star/internal/services"
+	"github.com/c12s/star/pkg/api"
 	rusapi "github.com/milossdjuric/rolling_update_service/pkg/api"
+	"github.com/nats-io/nats.go"
 	"google.golang.org/protobuf/proto"
 )
 
 type AppOperationAsyncServer struct {
-	client       *rusapi.UpdateServiceAsyncClient
-	dockerClient *client.Client
-	nodeId       string
+	client     *rusapi.UpdateServiceAsyncClient
+	conn       *nats.Conn
+	signer     domain.NodeSigner
+	runtime    domain.AppRuntime
+	images     domain.ImageDefaults
+	gc         *services.AppGarbageCollector
+	index      *services.AppIndex
+	supervisor *services.AppSupervisor
+	executor   *services.OperationExecutor
+	admission  *services.AppAdmission
+	audit      domain.AuditStore
+	requests   *operationRequests
+	followers  *logFollowers
+	// draining is set once the node is being decommissioned, apps aren't started anymore
+	draining atomic.Bool
+	nodeId   string
+	ctx      context.Context
+	cancel   context.CancelFunc
 }
 
-func NewAppOperationAsyncServer(client *rusapi.UpdateServiceAsyncClient, dockerClient *client.Client, nodeId string) (*AppOperationAsyncServer, error) {
+func NewAppOperationAsyncServer(client *rusapi.UpdateServiceAsyncClient, conn *nats.Conn, signer domain.NodeSigner, runtime domain.AppRuntime, images domain.ImageDefaults, gc *services.AppGarbageCollector, index *services.AppIndex, supervisor *services.AppSupervisor, executor *services.OperationExecutor, admission *services.AppAdmission, audit domain.AuditStore, nodeId string) (*AppOperationAsyncServer, error) {
 	if client == nil {
 		return nil, errors.New("client is nil while initializing app config async server")
 	}
+	ctx, cancel := context.WithCancel(context.Background())
 	return &AppOperationAsyncServer{
-		client:       client,
-		dockerClient: dockerClient,
-		nodeId:       nodeId,
+		client:     client,
+		conn:       conn,
+		signer:     signer,
+		runtime:    runtime,
+		images:     images,
+		gc:         gc,
+		index:      index,
+		supervisor: supervisor,
+		executor:   executor,
+		admission:  admission,
+		audit:      audit,
+		requests:   newOperationRequests(),
+		followers:  newLogFollowers(),
+		nodeId:     nodeId,
+		ctx:        ctx,
+		cancel:     cancel,
 	}, nil
 }
 
 func (c *AppOperationAsyncServer) Serve() {
-	err := c.client.ReceiveAppOperation(func(orgId, namespace, name, operation string, selectorLabels map[string]string, minReadySeconds int64) error {
-		ctx := context.Background()
-
-		log.Println("Received app operation: ", operation)
-
-		switch operation {
-		case "start":
-			go c.handleStartApp(ctx, name, selectorLabels)
-			return nil
-		case "stop":
-			go c.handleStopApp(ctx, name)
-			return nil
-		case "query":
-			go c.handleQueryApp(ctx, name, selectorLabels)
-			return nil
-		case "healthcheck":
-			go c.handleHealthCheckApp(ctx, name)
-			return nil
-		case "availabilitycheck":
-			go c.handleAvailabilityCheckApp(ctx, name, minReadySeconds)
-			return nil
-		case "query_healthy":
-			go c.handleQueryHealthyApp(ctx, name, selectorLabels)
-			return nil
-		case "query_available":
-			go c.handleQueryAvailableApp(ctx, name, selectorLabels, minReadySeconds)
-			return nil
-		case "query_all":
-			go c.handleQueryAllApp(ctx, name, selectorLabels, minReadySeconds)
-			return nil
-		default:
-			log.Printf("Unknown operation: %s", operation)
-			return fmt.Errorf("unknown operation: %s", operation)
+	// the command is decoded here instead of in ReceiveAppOperation,
+	// so star specific fields of the command are not dropped
+	err := c.client.Subscriber.Subscribe(func(msg []byte, _ string) {
+		cmd := &api.AppOperationCommand{}
+		err := proto.Unmarshal(msg, cmd)
+		if err != nil {
+			log.Println(err)
+			return
+		}
+		err = c.handleOperation(cmd)
+		if err != nil {
+			log.Println(err)
 		}
 	})
 
@@ -75,381 +86,531 @@ func (c *AppOperationAsyncServer) Serve() {
 	}
 }
 
-func (c *AppOperationAsyncServer) GracefulStop() {
-	c.client.GracefulStop()
-}
-
-func (c *AppOperationAsyncServer) handleStartApp(ctx context.Context, name string, selectorLabels map[string]string) {
+func (c *AppOperationAsyncServer) handleOperation(cmd *api.AppOperationCommand) error {
+	name, operation, selectorLabels, minReadySeconds := cmd.Name, cmd.Operation, cmd.SelectorLabels, cmd.MinReadySeconds
+	requestId := cmd.RequestId
+	timeout := time.Duration(cmd.TimeoutSeconds) * time.Second
+	selector := proto_mapper.LabelSelectorToDomain(selectorLabels, cmd.SelectorRequirements)
+	stopOptions := domain.StopOptions{
+		Signal:      cmd.StopSignal,
+		GracePeriod: time.Duration(cmd.GracePeriodSeconds) * time.Second,
+		PreStopHook: proto_mapper.PreStopHookToDomain(cmd.PreStopHook),
+	}
+
+	log.Println("Received app operation: ", operation, requestId)
+	if strings.HasPrefix(operation, "query") {
+		c.gc.Reference(name)
+	}
+
+	var handler func(ctx context.Context)
+	switch operation {
+	case "start":
+		spec := domain.AppSpec{
+			Name:       name,
+			Image:      cmd.Image,
+			Command:    cmd.Command,
+			Env:        cmd.Env,
+			WorkingDir: cmd.WorkingDir,
+			Labels:     selectorLabels,
+			Resources:  proto_mapper.ResourcesToDomain(cmd.Resources),
+			Namespace:  cmd.Namespace,
+		}
+		handler = func(ctx context.Context) {
+			c.handleStartApp(ctx, spec, cmd.ImagePullPolicy, cmd.RestartPolicy, stopOptions, requestId)
+		}
+	case "stop":
+		handler = func(ctx context.Context) {
+			c.handleStopApp(ctx, name, stopOptions, requestId)
+		}
+	case "remove":
+		handler = func(ctx context.Context) {
+			c.handleRemoveApp(ctx, name, requestId)
+		}
+	case "pause":
+		handler = func(ctx context.Context) {
+			c.handlePauseApp(ctx, name, requestId)
+		}
+	case "unpause":
+		handler = func(ctx context.Context) {
+			c.handleUnpauseApp(ctx, name, requestId)
+		}
+	case "restart":
+		handler = func(ctx context.Context) {
+			c.handleRestartApp(ctx, name, stopOptions, requestId)
+		}
+	case "logs":
+		handler = func(ctx context.Context) {
+			c.handleLogsApp(ctx, name, cmd.LogOptions, requestId)
+		}
+	case "logs_cancel":
+		handler = func(ctx context.Context) {
+			c.handleCancelLogsApp(ctx, name, cmd.LogOptions, requestId)
+		}
+	case "stats":
+		handler = func(ctx context.Context) {
+			c.handleStatsApp(ctx, name, requestId)
+		}
+	case "query":
+		handler = func(ctx context.Context) {
+			c.handleQueryApp(ctx, name, selector, requestId)
+		}
+	case "healthcheck":
+		handler = func(ctx context.Context) {
+			c.handleHealthCheckApp(ctx, name, requestId)
+		}
+	case "availabilitycheck":
+		handler = func(ctx context.Context) {
+			c.handleAvailabilityCheckApp(ctx, name, minReadySeconds, requestId)
+		}
+	case "query_healthy":
+		handler = func(ctx context.Context) {
+			c.handleQueryHealthyApp(ctx, name, selector, requestId)
+		}
+	case "query_available":
+		handler = func(ctx context.Context) {
+			c.handleQueryAvailableApp(ctx, name, selector, minReadySeconds, requestId)
+		}
+	case "query_all":
+		handler = func(ctx context.Context) {
+			c.handleQueryAllApp(ctx, name, selector, minReadySeconds, cmd.IncludeStats, requestId)
+		}
+	default:
+		log.Printf("Unknown operation: %s", operation)
+		err := fmt.Errorf("unknown operation: %s", operation)
+		c.auditOperation(cmd, operation, name, requestId, false, []string{err.Error()})
+		return err
+	}
 
-	errorMessages := make([]string, 0)
-	containerConfig := &container.Config{
-		Image:  os.Getenv("DOCKER_CLIENT_IMAGE"),
-		Cmd:    []string{"ash", "-c", "while true; do sleep 1000; done"},
-		Labels: selectorLabels,
+	execute, response := c.requests.begin(requestId)
+	if response != nil {
+		log.Printf("Request %s already answered, publishing the response again", requestId)
+		return c.publish(response.data, response.subject)
+	}
+	if !execute {
+		log.Printf("Request %s is already being executed", requestId)
+		return nil
 	}
 
-	resp, err := c.dockerClient.ContainerCreate(ctx, containerConfig, nil, nil, nil, name)
+	// operations on the same container are serialized by the executor
+	err := c.executor.Submit(name, operation, timeout, func(ctx context.Context) {
+		defer c.requests.abort(requestId)
+		handler(context.WithValue(ctx, operationCommandKey{}, cmd))
+	})
 	if err != nil {
-		errorMessages = append(errorMessages, fmt.Sprintf("Error creating container: %s", err))
-		log.Println("Error creating container: ", err)
-	} else {
-		// log.Println("Container created successfully: ", resp.ID)
+		c.requests.abort(requestId)
+		err = fmt.Errorf("%s operation on %s not executed: %w", operation, name, err)
+		c.auditOperation(cmd, operation, name, requestId, false, []string{err.Error()})
+		return err
 	}
+	return nil
+}
 
-	if err := c.dockerClient.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
-		log.Printf("Error starting container: %s", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Error starting container: %s", err))
-	} else {
-		// log.Println("Container started successfully: ", resp.ID)
-	}
+// operationCommandKey holds the command in the context handlers are called with, for auditing
+type operationCommandKey struct{}
 
-	response := rusapi.StartAppResp{
-		Success:       err == nil,
-		ErrorMessages: errorMessages,
+// publishResponse publishes to <nodeId>.app_operation.<operation>.<name>,
+// followed by .<requestId> when the request carried one, and audits the outcome
+func (c *AppOperationAsyncServer) publishResponse(ctx context.Context, response proto.Message, operation, name, requestId string) {
+	if result, ok := response.(operationResult); ok {
+		cmd, _ := ctx.Value(operationCommandKey{}).(*api.AppOperationCommand)
+		c.auditOperation(cmd, operation, name, requestId, result.GetSuccess(), result.GetErrorMessages())
 	}
 
-	data, err := proto.Marshal(&response)
+	data, err := proto.Marshal(response)
 	if err != nil {
 		log.Printf("Failed to marshal response: %v", err)
 		return
 	}
-
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.start_app."+name)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.start_app."+name)
-}
-
-func (c *AppOperationAsyncServer) handleStopApp(ctx context.Context, name string) {
-	errorMessages := make([]string, 0)
-	err := c.dockerClient.ContainerStop(ctx, name, container.StopOptions{})
+	subject := c.nodeId + ".app_operation." + operation + "." + name
+	if requestId != "" {
+		subject += "." + requestId
+	}
+	c.requests.finish(requestId, subject, data)
+	err = c.publish(data, subject)
 	if err != nil {
-		log.Printf("Error stopping container: %s", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Error stopping container: %s", err))
-	} else {
-		// log.Printf("Container %s stopped successfully", name)
+		log.Printf("Failed to publish response to %s: %v", subject, err)
+		return
 	}
+	log.Println("Response published to NATS topic: ", subject)
+}
 
-	response := rusapi.StopAppResp{
-		Success:       err == nil,
+// publish signs what is published, responses go out over the nats connection
+// since the rus publisher can't send headers
+func (c *AppOperationAsyncServer) publish(data []byte, subject string) error {
+	return c.conn.PublishMsg(services.SignedMsg(c.signer, c.nodeId, subject, data))
+}
+
+func (c *AppOperationAsyncServer) auditOperation(cmd *api.AppOperationCommand, operation, name, requestId string, success bool, errorMessages []string) {
+	entry := domain.AuditEntry{
+		Kind:          domain.AuditKindAppOperation,
+		Operation:     operation,
+		Name:          name,
+		RequestId:     requestId,
+		Subject:       rusapi.Subject(c.nodeId),
+		Success:       success,
 		ErrorMessages: errorMessages,
 	}
-
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
+	if cmd != nil {
+		entry.Parameters = auditParameters(cmd)
 	}
+	appendAudit(c.audit, entry)
+}
 
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.stop_app."+name)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.stop_app."+name)
+func (c *AppOperationAsyncServer) GracefulStop() {
+	c.client.GracefulStop()
+	c.cancel()
 }
 
-func (c *AppOperationAsyncServer) handleQueryApp(ctx context.Context, prefix string, selectorLabels map[string]string) {
-	log.Printf("Querying app containers with prefix: %s and selectorLabels: %v", prefix, selectorLabels)
+// imagePullError is reported apart from errors creating or starting the container
+type imagePullError struct {
+	err error
+}
+
+func (e imagePullError) Error() string {
+	return fmt.Sprintf("Error pulling image: %s", e.err)
+}
 
+func (c *AppOperationAsyncServer) handleStartApp(ctx context.Context, spec domain.AppSpec, pullPolicy string, restartPolicyCmd *api.RestartPolicy, stopOptions domain.StopOptions, requestId string) {
+	name := spec.Name
 	errorMessages := make([]string, 0)
-	keyValues := make([]filters.KeyValuePair, 0)
-	keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: "revision=" + prefix})
-	for key, value := range selectorLabels {
-		keyValue := key + "=" + value
-		keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: keyValue})
+	imagePullErrors := make([]string, 0)
+	var admissionRejection *api.AdmissionRejection
+	containerId := ""
+	selectorLabels := spec.Labels
+	spec.StopSignal = stopOptions.Signal
+	spec.StopGracePeriod = stopOptions.GracePeriod
+
+	var policy domain.ImagePullPolicy
+	var restartPolicy domain.RestartPolicy
+	var err error
+	// checked when the operation runs, starts queued before the drain began are rejected too
+	if c.draining.Load() {
+		err = errors.New("node is being decommissioned")
+	}
+	if err == nil && !spec.Resources.Valid() {
+		err = errors.New("invalid resources: amounts can't be negative")
+	}
+	if err == nil {
+		restartPolicy, err = proto_mapper.RestartPolicyToDomain(restartPolicyCmd)
+	}
+	if err == nil {
+		spec.Image, policy, err = c.images.Resolve(spec.Image, pullPolicy)
+	}
+	if err == nil {
+		spec.Labels, err = containerLabels(selectorLabels, spec.Namespace, stopOptions.PreStopHook, restartPolicy, spec.Resources)
+	}
+	// admitted before the runtime is called, the reservation is held until the container carries it
+	if err == nil {
+		var release func()
+		release, err = c.admission.Admit(ctx, name, spec.Namespace, spec.Resources)
+		if err == nil {
+			defer release()
+		}
+	}
+	if err == nil {
+		c.gc.Reference(selectorLabels[domain.AppRevisionLabel])
+		containerId, err = c.startContainer(ctx, spec, policy)
 	}
-	args := filters.NewArgs(keyValues...)
-
-	containers, err := c.dockerClient.ContainerList(ctx, container.ListOptions{Filters: args})
 	if err != nil {
-		log.Printf("Failed to list containers: %v", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Failed to list containers: %v", err))
+		log.Printf("Error starting container: %s", err)
+		errorMessages = append(errorMessages, err.Error())
+		if pullErr := (imagePullError{}); errors.As(err, &pullErr) {
+			imagePullErrors = append(imagePullErrors, pullErr.err.Error())
+		}
+		if admissionErr := (*domain.AdmissionError)(nil); errors.As(err, &admissionErr) {
+			admissionRejection = proto_mapper.AdmissionRejectionFromDomain(admissionErr)
+		}
 	} else {
-		// log.Printf("Found %d containers matching query", len(containers))
+		c.supervisor.Track(name, restartPolicy)
 	}
-
-	// when calling docker API, it returns container names with "/" prefix
-	apps := make([]*rusapi.App, 0)
-	for _, container := range containers {
-		log.Printf("Container found: %v", container)
-		beforeContainerName, containerName, _ := strings.Cut(container.Names[0], "/")
-		if containerName == "" {
-			containerName = beforeContainerName
+	var app *api.NodeApp
+	if err == nil {
+		container, refreshErr := c.index.Refresh(ctx, containerId)
+		if refreshErr != nil {
+			log.Printf("Failed to inspect started container: %s", refreshErr)
+		} else {
+			app = c.app(container)
 		}
-		apps = append(apps, &rusapi.App{Name: containerName, SelectorLabels: container.Labels})
-		log.Printf("App found: %s", apps[len(apps)-1].Name)
 	}
 
-	response := rusapi.QueryAppResp{
-		Success:       err == nil,
-		ErrorMessages: errorMessages,
-		Apps:          apps,
+	response := api.NodeStartAppResp{
+		RequestId:          requestId,
+		Success:            err == nil,
+		ErrorMessages:      errorMessages,
+		ContainerId:        containerId,
+		ImagePullErrors:    imagePullErrors,
+		App:                app,
+		AdmissionRejection: admissionRejection,
 	}
 
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
+	c.publishResponse(ctx, &response, "start_app", name, requestId)
+}
+
+// startContainer is idempotent, a container created from the same spec is reused
+// and started if needed, a stopped container that differs is replaced
+func (c *AppOperationAsyncServer) startContainer(ctx context.Context, spec domain.AppSpec, pullPolicy domain.ImagePullPolicy) (string, error) {
+	name := spec.Name
+	hash := spec.Hash()
+	spec.Labels = maps.Clone(spec.Labels)
+	if spec.Labels == nil {
+		spec.Labels = make(map[string]string)
+	}
+	spec.Labels[domain.AppSpecHashLabel] = hash
+	existing, err := c.runtime.Inspect(ctx, name)
+	if err != nil && !errors.Is(err, domain.ErrAppNotFound) {
+		return "", fmt.Errorf("Error inspecting container: %s", err)
+	}
+	if err == nil {
+		// the runtime adds the image's labels to the container, so the labels can't be compared as they are
+		identical := existing.Labels[domain.AppSpecHashLabel] == hash
+		active := existing.Running || existing.Paused || existing.State == "restarting"
+		switch {
+		case identical && active:
+			log.Printf("Container %s is already %s", name, existing.State)
+			return existing.Id, nil
+		case identical:
+			if err := c.runtime.Start(ctx, name); err != nil {
+				return existing.Id, fmt.Errorf("Error starting container: %s", err)
+			}
+			return existing.Id, nil
+		case active:
+			return existing.Id, fmt.Errorf("Error creating container: container %s already exists with a different configuration and is %s", name, existing.State)
+		}
+		log.Printf("Removing stopped container %s before recreating it", name)
+		err = c.runtime.Remove(ctx, name)
+		if err != nil {
+			return existing.Id, fmt.Errorf("Error removing stale container: %s", err)
+		}
 	}
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.query_app."+prefix)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.query_app."+prefix)
 
+	err = c.runtime.EnsureImage(ctx, spec.Image, pullPolicy)
+	if err != nil {
+		return "", imagePullError{err: err}
+	}
+	containerId, err := c.runtime.Create(ctx, spec)
+	if err != nil {
+		return "", fmt.Errorf("Error creating container: %s", err)
+	}
+	if err := c.runtime.Start(ctx, name); err != nil {
+		return containerId, fmt.Errorf("Error starting container: %s", err)
+	}
+	return containerId, nil
 }
 
-func (c *AppOperationAsyncServer) handleHealthCheckApp(ctx context.Context, name string) {
-	log.Printf("Health check for container: %s", name)
-
+func (c *AppOperationAsyncServer) handleStopApp(ctx context.Context, name string, options domain.StopOptions, requestId string) {
 	errorMessages := make([]string, 0)
-	healthy := false
-	containerInfo, err := c.dockerClient.ContainerInspect(ctx, name)
+	preStopHookErrors, err := c.stopApp(ctx, name, options)
 	if err != nil {
-		log.Printf("Failed to inspect container: %v", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Failed to inspect container: %v", err))
-	} else {
-		if containerInfo.State.Running {
-			healthy = true
-			log.Printf("Container %s is running", name)
-		} else {
-			log.Printf("Container %s is not running", name)
-		}
+		log.Printf("Error stopping container: %s", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Error stopping container: %s", err))
 	}
 
-	response := rusapi.HealthCheckAppResp{
-		Success:       err == nil,
-		ErrorMessages: errorMessages,
-		Healthy:       healthy,
+	response := api.NodeStopAppResp{
+		RequestId:         requestId,
+		Success:           err == nil,
+		ErrorMessages:     errorMessages,
+		PreStopHookErrors: preStopHookErrors,
 	}
 
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
-	}
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.healthcheck_app."+name)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.healthcheck_app."+name)
+	c.publishResponse(ctx, &response, "stop_app", name, requestId)
 }
 
-func (c *AppOperationAsyncServer) handleAvailabilityCheckApp(ctx context.Context, name string, minReadySeconds int64) {
-	log.Printf("Availability check for container: %s with minReadySeconds: %d", name, minReadySeconds)
+// stopApp stops supervising the app and runs its pre-stop hook before stopping it,
+// an app that doesn't exist is already stopped
+func (c *AppOperationAsyncServer) stopApp(ctx context.Context, name string, options domain.StopOptions) ([]string, error) {
+	preStopHookErrors := make([]string, 0)
+	c.supervisor.Untrack(name)
+
+	hook, err := c.preStopHook(ctx, name, options.PreStopHook)
+	if err == nil && hook != nil {
+		if hookErr := c.runPreStopHook(ctx, name, hook); hookErr != nil {
+			log.Printf("Pre-stop hook of %s failed: %s", name, hookErr)
+			preStopHookErrors = append(preStopHookErrors, hookErr.Error())
+		}
+	}
+	if err == nil {
+		err = c.runtime.Stop(ctx, name, options)
+	}
+	if errors.Is(err, domain.ErrAppNotFound) {
+		log.Printf("Container %s does not exist, nothing to stop", name)
+		err = nil
+	}
+	return preStopHookErrors, err
+}
 
+func (c *AppOperationAsyncServer) handleRemoveApp(ctx context.Context, name string, requestId string) {
 	errorMessages := make([]string, 0)
-	available := false
-	containerInfo, err := c.dockerClient.ContainerInspect(ctx, name)
+	c.supervisor.Untrack(name)
+	err := c.runtime.Remove(ctx, name)
+	if errors.Is(err, domain.ErrAppNotFound) {
+		log.Printf("Container %s does not exist, nothing to remove", name)
+		err = nil
+	}
 	if err != nil {
-		log.Printf("Failed to inspect container: %v", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Failed to inspect container: %v", err))
-	} else {
-		if containerInfo.State.Running {
-			startedAt := containerInfo.State.StartedAt
-			startTime, err := time.Parse(time.RFC3339Nano, startedAt)
-			if err != nil {
-				log.Printf("Failed to parse time: %v", err)
-				errorMessages = append(errorMessages, fmt.Sprintf("Failed to parse time: %v", err))
-			} else {
-				if time.Since(startTime).Seconds() >= float64(minReadySeconds) {
-					available = true
-					log.Printf("Container %s is available", name)
-				} else {
-					log.Printf("Container %s is not available", name)
-				}
-			}
-		}
+		log.Printf("Error removing container: %s", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Error removing container: %s", err))
 	}
 
-	response := rusapi.AvailabilityCheckAppResp{
+	response := api.RemoveAppResp{
+		RequestId:     requestId,
 		Success:       err == nil,
 		ErrorMessages: errorMessages,
-		Available:     available,
 	}
 
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
-	}
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.availabilitycheck_app."+name)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.availabilitycheck_app."+name)
+	c.publishResponse(ctx, &response, "remove_app", name, requestId)
 }
 
-func (c *AppOperationAsyncServer) handleQueryHealthyApp(ctx context.Context, prefix string, selectorLabels map[string]string) {
-	log.Printf("Querying app containers with prefix: %s and selectorLabels: %v", prefix, selectorLabels)
-
-	errorMessages := make([]string, 0)
-	keyValues := make([]filters.KeyValuePair, 0)
-	keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: "revision=" + prefix})
-	for key, value := range selectorLabels {
-		keyValue := key + "=" + value
-		keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: keyValue})
-	}
-	args := filters.NewArgs(keyValues...)
+// app leaves out the labels star sets for itself
+func (c *AppOperationAsyncServer) app(container domain.AppContainer) *api.NodeApp {
+	container.Labels = maps.Clone(container.Labels)
+	delete(container.Labels, domain.AppPreStopHookLabel)
+	delete(container.Labels, domain.AppRestartPolicyLabel)
+	delete(container.Labels, domain.AppResourcesLabel)
+	delete(container.Labels, domain.AppNamespaceLabel)
+	delete(container.Labels, domain.AppSpecHashLabel)
+	return proto_mapper.AppFromDomain(container, c.supervisor.RestartCount(container.Name))
+}
 
-	containers, err := c.dockerClient.ContainerList(ctx, container.ListOptions{Filters: args})
+// queryApps reads the running apps matching the query from the app index and
+// splits them into all of them, the healthy ones and the available ones
+func (c *AppOperationAsyncServer) queryApps(prefix string, selector domain.LabelSelector, minReadySeconds int64) ([]*api.NodeApp, []*api.NodeApp, []*api.NodeApp, error) {
+	containers, err := c.index.List(prefix, selector)
 	if err != nil {
-		log.Printf("Failed to list containers: %v", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Failed to list containers: %v", err))
-	} else {
-		// log.Printf("Found %d containers matching query", len(containers))
+		return nil, nil, nil, err
 	}
 
-	apps := make([]*rusapi.App, 0)
+	now := time.Now()
+	minReady := time.Duration(minReadySeconds) * time.Second
+	totalApps := make([]*api.NodeApp, 0)
+	healthyApps := make([]*api.NodeApp, 0)
+	availableApps := make([]*api.NodeApp, 0)
 	for _, container := range containers {
-		beforeContainerName, containerName, _ := strings.Cut(container.Names[0], "/")
-		if containerName == "" {
-			containerName = beforeContainerName
+		app := c.app(container)
+		totalApps = append(totalApps, app)
+		if container.Healthy() {
+			healthyApps = append(healthyApps, app)
 		}
-
-		containerInfo, err := c.dockerClient.ContainerInspect(ctx, containerName)
-		if err != nil {
-			log.Printf("Failed to inspect container: %v", err)
-			errorMessages = append(errorMessages, fmt.Sprintf("Failed to inspect container: %v", err))
-		} else {
-			if containerInfo.State.Running {
-				apps = append(apps, &rusapi.App{Name: containerName, SelectorLabels: container.Labels})
-				log.Printf("App found: %s", apps[len(apps)-1].Name)
-				log.Printf("Container %s is running", containerName)
-			} else {
-				log.Printf("Container %s is not running", containerName)
-			}
+		if container.Available(minReady, now) {
+			availableApps = append(availableApps, app)
 		}
 	}
+	return totalApps, healthyApps, availableApps, nil
+}
+
+func (c *AppOperationAsyncServer) handleQueryApp(ctx context.Context, prefix string, selector domain.LabelSelector, requestId string) {
+	c.handleQuery(ctx, prefix, selector, 0, "query_app", requestId, func(total, healthy, available []*api.NodeApp) []*api.NodeApp {
+		return total
+	})
+}
+
+func (c *AppOperationAsyncServer) handleQueryHealthyApp(ctx context.Context, prefix string, selector domain.LabelSelector, requestId string) {
+	c.handleQuery(ctx, prefix, selector, 0, "query_healthy_app", requestId, func(total, healthy, available []*api.NodeApp) []*api.NodeApp {
+		return healthy
+	})
+}
+
+func (c *AppOperationAsyncServer) handleQueryAvailableApp(ctx context.Context, prefix string, selector domain.LabelSelector, minReadySeconds int64, requestId string) {
+	c.handleQuery(ctx, prefix, selector, minReadySeconds, "query_available_app", requestId, func(total, healthy, available []*api.NodeApp) []*api.NodeApp {
+		return available
+	})
+}
+
+func (c *AppOperationAsyncServer) handleQuery(ctx context.Context, prefix string, selector domain.LabelSelector, minReadySeconds int64, operation, requestId string, pick func(total, healthy, available []*api.NodeApp) []*api.NodeApp) {
+	log.Printf("Querying app containers with prefix: %s and selector: %s", prefix, selector)
+
+	errorMessages := make([]string, 0)
+	apps := make([]*api.NodeApp, 0)
+	total, healthy, available, err := c.queryApps(prefix, selector, minReadySeconds)
+	if err != nil {
+		log.Printf("Failed to query apps: %v", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Failed to query apps: %v", err))
+	} else {
+		apps = pick(total, healthy, available)
+	}
 
-	response := rusapi.QueryAppResp{
+	response := api.NodeQueryAppResp{
+		RequestId:     requestId,
 		Success:       err == nil,
 		ErrorMessages: errorMessages,
 		Apps:          apps,
 	}
 
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
-	}
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.query_healthy_app."+prefix)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.query_healthy_app."+prefix)
+	c.publishResponse(ctx, &response, operation, prefix, requestId)
 }
 
-func (c *AppOperationAsyncServer) handleQueryAvailableApp(ctx context.Context, prefix string, selectorLabels map[string]string, minReadySeconds int64) {
-	log.Printf("Querying app containers with prefix: %s and selectorLabels: %v", prefix, selectorLabels)
+func (c *AppOperationAsyncServer) handleQueryAllApp(ctx context.Context, prefix string, selector domain.LabelSelector, minReadySeconds int64, includeStats bool, requestId string) {
+	log.Printf("Querying app containers with prefix: %s and selector: %s", prefix, selector)
 
 	errorMessages := make([]string, 0)
-	keyValues := make([]filters.KeyValuePair, 0)
-	keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: "revision=" + prefix})
-	for key, value := range selectorLabels {
-		keyValue := key + "=" + value
-		keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: keyValue})
-	}
-	args := filters.NewArgs(keyValues...)
-
-	containers, err := c.dockerClient.ContainerList(ctx, container.ListOptions{Filters: args})
+	totalApps, readyApps, availableApps, err := c.queryApps(prefix, selector, minReadySeconds)
 	if err != nil {
-		log.Printf("Failed to list containers: %v", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Failed to list containers: %v", err))
+		log.Printf("Failed to query apps: %v", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Failed to query apps: %v", err))
 	}
-
-	apps := make([]*rusapi.App, 0)
-	for _, container := range containers {
-		beforeContainerName, containerName, _ := strings.Cut(container.Names[0], "/")
-		if containerName == "" {
-			containerName = beforeContainerName
-		}
-
-		containerInfo, err := c.dockerClient.ContainerInspect(ctx, containerName)
-		if err != nil {
-			log.Printf("Failed to inspect container: %v", err)
-			errorMessages = append(errorMessages, fmt.Sprintf("Failed to inspect container: %v", err))
-		} else {
-			if containerInfo.State.Running {
-				startedAt := containerInfo.State.StartedAt
-				startTime, err := time.Parse(time.RFC3339Nano, startedAt)
-				if err != nil {
-					log.Printf("Failed to parse time: %v", err)
-					errorMessages = append(errorMessages, fmt.Sprintf("Failed to parse time: %v", err))
-				} else {
-					if time.Since(startTime).Seconds() >= float64(minReadySeconds) {
-						apps = append(apps, &rusapi.App{Name: containerName, SelectorLabels: container.Labels})
-					}
-				}
-			}
-		}
+	stats := make([]*api.AppStats, 0)
+	if includeStats {
+		var statsErrorMessages []string
+		stats, statsErrorMessages = c.collectStats(ctx, readyApps)
+		errorMessages = append(errorMessages, statsErrorMessages...)
 	}
 
-	response := rusapi.QueryAppResp{
+	response := api.NodeQueryAllAppResp{
+		RequestId:     requestId,
 		Success:       err == nil,
 		ErrorMessages: errorMessages,
-		Apps:          apps,
+		TotalApps:     totalApps,
+		ReadyApps:     readyApps,
+		AvailableApps: availableApps,
+		Stats:         stats,
 	}
 
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		log.Printf("Failed to marshal response: %v", err)
-	}
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.query_available_app."+prefix)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.query_available_app."+prefix)
+	c.publishResponse(ctx, &response, "query_all_app", prefix, requestId)
 }
 
-func (c *AppOperationAsyncServer) handleQueryAllApp(ctx context.Context, prefix string, selectorLabels map[string]string, minReadySeconds int64) {
-	log.Printf("Querying app containers with prefix: %s and selectorLabels: %v", prefix, selectorLabels)
-
-	// we use seperate goroutine to handle this operation, since it is only read
-	// operation and we dont need to block server thread
+func (c *AppOperationAsyncServer) handleHealthCheckApp(ctx context.Context, name string, requestId string) {
+	log.Printf("Health check for container: %s", name)
 
-	// in this method if error occurs we log it inside goroutine, so we dont block server thread,
-	// otherwise we would stil return err just to log it if not nil
 	errorMessages := make([]string, 0)
-	keyValues := make([]filters.KeyValuePair, 0)
-	keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: "revision=" + prefix})
-	for key, value := range selectorLabels {
-		keyValue := key + "=" + value
-		keyValues = append(keyValues, filters.KeyValuePair{Key: "label", Value: keyValue})
+	container, err := c.indexedContainer(name)
+	if err != nil {
+		log.Printf("Failed to find container: %v", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Failed to find container: %v", err))
 	}
-	args := filters.NewArgs(keyValues...)
 
-	containers, err := c.dockerClient.ContainerList(ctx, container.ListOptions{Filters: args})
-	if err != nil {
-		// since this is a goroutine, we need to log the error
-		log.Printf("Failed to list containers: %v", err)
-		errorMessages = append(errorMessages, fmt.Sprintf("Failed to list containers: %v", err))
+	response := api.NodeHealthCheckAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+		Healthy:       err == nil && container.Healthy(),
 	}
 
-	totalApps := make([]*rusapi.App, 0)
-	readyApps := make([]*rusapi.App, 0)
-	availableApps := make([]*rusapi.App, 0)
-	for _, container := range containers {
-		beforeContainerName, containerName, _ := strings.Cut(container.Names[0], "/")
-		if containerName == "" {
-			containerName = beforeContainerName
-		}
+	c.publishResponse(ctx, &response, "healthcheck_app", name, requestId)
+}
 
-		containerInfo, err := c.dockerClient.ContainerInspect(ctx, containerName)
-		if err != nil {
-			log.Printf("Failed to inspect container: %v", err)
-			errorMessages = append(errorMessages, fmt.Sprintf("Failed to inspect container: %v", err))
-		} else {
-			totalApps = append(totalApps, &rusapi.App{Name: containerName, SelectorLabels: container.Labels})
-
-			if containerInfo.State.Running {
-				readyApps = append(readyApps, &rusapi.App{Name: containerName, SelectorLabels: container.Labels})
-
-				startedAt := containerInfo.State.StartedAt
-				startTime, err := time.Parse(time.RFC3339Nano, startedAt)
-				if err != nil {
-					log.Printf("Failed to parse time: %v", err)
-					errorMessages = append(errorMessages, fmt.Sprintf("Failed to parse time: %v", err))
-				} else {
-					if time.Since(startTime).Seconds() >= float64(minReadySeconds) {
-						availableApps = append(availableApps, &rusapi.App{Name: containerName, SelectorLabels: container.Labels})
-						log.Printf("Container %s is available", containerName)
-					} else {
-						// log.Printf("Container %s is not available", containerName)
-					}
-				}
-			}
-		}
+func (c *AppOperationAsyncServer) handleAvailabilityCheckApp(ctx context.Context, name string, minReadySeconds int64, requestId string) {
+	log.Printf("Availability check for container: %s with minReadySeconds: %d", name, minReadySeconds)
+
+	errorMessages := make([]string, 0)
+	container, err := c.indexedContainer(name)
+	if err != nil {
+		log.Printf("Failed to find container: %v", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Failed to find container: %v", err))
 	}
-	response := rusapi.QueryAllAppResp{
+
+	response := api.NodeAvailabilityCheckAppResp{
+		RequestId:     requestId,
 		Success:       err == nil,
 		ErrorMessages: errorMessages,
-		TotalApps:     totalApps,
-		ReadyApps:     readyApps,
-		AvailableApps: availableApps,
+		Available:     err == nil && container.Available(time.Duration(minReadySeconds)*time.Second, time.Now()),
 	}
 
-	data, err := proto.Marshal(&response)
-	if err != nil {
-		// since this is a goroutine, we need to log the error
-		log.Printf("Failed to marshal response: %v", err)
-		return
+	c.publishResponse(ctx, &response, "availabilitycheck_app", name, requestId)
+}
+
+func (c *AppOperationAsyncServer) indexedContainer(name string) (domain.AppContainer, error) {
+	container, ok := c.index.Get(name)
+	if !ok {
+		return container, fmt.Errorf("container %s not found", name)
 	}
-	c.client.Publisher.Publish(data, c.nodeId+".app_operation.query_all_app."+prefix)
-	log.Println("Response published to NATS topic: ", c.nodeId+".app_operation.query_all_app."+prefix)
+	return container, nil
 }
@@ -0,0 +1,89 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+// drainPasses covers starts that were already running when the drain began
+const drainPasses = 2
+
+// Drain stops and removes every app on the node, apps aren't started from then on.
+// Each app is drained through the executor, so operations already queued for it finish
+// first. It returns the names of the drained apps and the errors of the ones that weren't.
+func (c *AppOperationAsyncServer) Drain(ctx context.Context, options domain.StopOptions) ([]string, []error) {
+	c.draining.Store(true)
+	drained := make([]string, 0)
+	errs := make([]error, 0)
+	for pass := 0; pass < drainPasses; pass++ {
+		apps, err := c.runtime.List(ctx)
+		if err != nil {
+			return drained, append(errs, fmt.Errorf("listing apps: %w", err))
+		}
+		passDrained, passErrs := c.drainApps(ctx, apps, options)
+		drained = append(drained, passDrained...)
+		errs = append(errs, passErrs...)
+		if len(apps) == 0 || len(passErrs) > 0 {
+			break
+		}
+	}
+	return drained, errs
+}
+
+// Resume accepts starts again after a drain, when the decommission it was part of failed
+func (c *AppOperationAsyncServer) Resume() {
+	c.draining.Store(false)
+}
+
+func (c *AppOperationAsyncServer) drainApps(ctx context.Context, apps []domain.AppContainer, options domain.StopOptions) ([]string, []error) {
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	drained := make([]string, 0, len(apps))
+	errs := make([]error, 0)
+	for _, app := range apps {
+		name := app.Name
+		wg.Add(1)
+		err := c.executor.Submit(name, "drain", 0, func(ctx context.Context) {
+			defer wg.Done()
+			err := c.drainApp(ctx, name, options)
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("draining %s: %w", name, err))
+				return
+			}
+			drained = append(drained, name)
+		})
+		if err != nil {
+			wg.Done()
+			lock.Lock()
+			errs = append(errs, fmt.Errorf("draining %s: %w", name, err))
+			lock.Unlock()
+		}
+	}
+	wg.Wait()
+	return drained, errs
+}
+
+func (c *AppOperationAsyncServer) drainApp(ctx context.Context, name string, options domain.StopOptions) error {
+	preStopHookErrors, err := c.stopApp(ctx, name, options)
+	for _, hookErr := range preStopHookErrors {
+		log.Printf("Pre-stop hook of %s failed while draining: %s", name, hookErr)
+	}
+	if err != nil {
+		return err
+	}
+	err = c.runtime.Remove(ctx, name)
+	if errors.Is(err, domain.ErrAppNotFound) {
+		err = nil
+	}
+	if err == nil {
+		log.Printf("Drained app %s", name)
+	}
+	return err
+}
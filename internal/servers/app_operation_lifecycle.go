@@ -0,0 +1,184 @@
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"net/http"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
+)
+
+func (c *AppOperationAsyncServer) handlePauseApp(ctx context.Context, name string, requestId string) {
+	errorMessages := make([]string, 0)
+	existing, err := c.runtime.Inspect(ctx, name)
+	if err == nil {
+		if existing.Paused {
+			log.Printf("Container %s is already paused", name)
+		} else {
+			err = c.runtime.Pause(ctx, name)
+		}
+	}
+	if err != nil {
+		log.Printf("Error pausing container: %s", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Error pausing container: %s", err))
+	}
+
+	response := api.PauseAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+	}
+
+	c.publishResponse(ctx, &response, "pause_app", name, requestId)
+}
+
+func (c *AppOperationAsyncServer) handleUnpauseApp(ctx context.Context, name string, requestId string) {
+	errorMessages := make([]string, 0)
+	existing, err := c.runtime.Inspect(ctx, name)
+	if err == nil {
+		if !existing.Paused {
+			log.Printf("Container %s is not paused", name)
+		} else {
+			err = c.runtime.Unpause(ctx, name)
+		}
+	}
+	if err != nil {
+		log.Printf("Error unpausing container: %s", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Error unpausing container: %s", err))
+	}
+
+	response := api.UnpauseAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+	}
+
+	c.publishResponse(ctx, &response, "unpause_app", name, requestId)
+}
+
+// handleRestartApp sends the stop signal and gives the container the grace period
+// to exit before it is killed, both default to the ones the app was started with
+func (c *AppOperationAsyncServer) handleRestartApp(ctx context.Context, name string, options domain.StopOptions, requestId string) {
+	errorMessages := make([]string, 0)
+
+	c.supervisor.Restarting(name, true)
+	err := c.runtime.Restart(ctx, name, options)
+	if err != nil {
+		c.supervisor.Restarting(name, false)
+		log.Printf("Error restarting container: %s", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Error restarting container: %s", err))
+	}
+
+	response := api.RestartAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+	}
+
+	c.publishResponse(ctx, &response, "restart_app", name, requestId)
+}
+
+// containerLabels adds the default pre-stop hook, the restart policy, the reserved resources
+// of the app and the namespace they are reserved in to its selector labels
+func containerLabels(selectorLabels map[string]string, namespace string, hook *domain.PreStopHook, restartPolicy domain.RestartPolicy, resources domain.Resources) (map[string]string, error) {
+	if hook == nil && !restartPolicy.Restarts() && resources.IsZero() {
+		return selectorLabels, nil
+	}
+	labels := maps.Clone(selectorLabels)
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	if hook != nil {
+		data, err := json.Marshal(hook)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding pre-stop hook: %s", err)
+		}
+		labels[domain.AppPreStopHookLabel] = string(data)
+	}
+	if restartPolicy.Restarts() {
+		data, err := json.Marshal(restartPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding restart policy: %s", err)
+		}
+		labels[domain.AppRestartPolicyLabel] = string(data)
+	}
+	if !resources.IsZero() {
+		data, err := json.Marshal(resources)
+		if err != nil {
+			return nil, fmt.Errorf("Error encoding resources: %s", err)
+		}
+		labels[domain.AppResourcesLabel] = string(data)
+		if namespace != "" {
+			labels[domain.AppNamespaceLabel] = namespace
+		}
+	}
+	return labels, nil
+}
+
+// preStopHook returns the hook given in the request, or else the default one of the container
+func (c *AppOperationAsyncServer) preStopHook(ctx context.Context, name string, hook *domain.PreStopHook) (*domain.PreStopHook, error) {
+	if hook != nil {
+		return hook, nil
+	}
+	existing, err := c.runtime.Inspect(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.Running {
+		return nil, nil
+	}
+	data, ok := existing.Labels[domain.AppPreStopHookLabel]
+	if !ok {
+		return nil, nil
+	}
+	hook = &domain.PreStopHook{}
+	err = json.Unmarshal([]byte(data), hook)
+	if err != nil {
+		// a broken default hook shouldn't keep the app from being stopped
+		log.Printf("Invalid pre-stop hook of %s: %s", name, err)
+		return nil, nil
+	}
+	return hook, nil
+}
+
+func (c *AppOperationAsyncServer) runPreStopHook(ctx context.Context, name string, hook *domain.PreStopHook) error {
+	ctx, cancel := context.WithTimeout(ctx, hook.Timeout)
+	defer cancel()
+	if len(hook.Exec) > 0 {
+		return c.execPreStopHook(ctx, name, hook.Exec)
+	}
+	return httpPreStopHook(ctx, hook.HTTPMethod, hook.HTTPURL)
+}
+
+func (c *AppOperationAsyncServer) execPreStopHook(ctx context.Context, name string, cmd []string) error {
+	exitCode, err := c.runtime.Exec(ctx, name, cmd)
+	if err != nil {
+		return fmt.Errorf("Error running pre-stop exec: %s", err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("Pre-stop exec exited with code %d", exitCode)
+	}
+	return nil
+}
+
+func httpPreStopHook(ctx context.Context, method, url string) error {
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return fmt.Errorf("Error creating pre-stop request: %s", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error sending pre-stop request: %s", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Pre-stop request returned status %s", resp.Status)
+	}
+	return nil
+}
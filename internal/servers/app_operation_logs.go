@@ -0,0 +1,212 @@
+package servers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultLogMaxBytes = 256 * 1024
+	// stdout and stderr together have to fit into the default NATS max payload
+	maxLogMaxBytes = 448 * 1024
+)
+
+// cappedBuffer keeps the last limit bytes written to it and drops what came before,
+// the newest lines of a log are the ones asked for
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	if n > b.limit {
+		b.truncated = true
+		b.buf.Reset()
+		p = p[n-b.limit:]
+	}
+	b.buf.Write(p)
+	if over := b.buf.Len() - b.limit; over > 0 {
+		b.truncated = true
+		b.buf.Next(over)
+	}
+	return n, nil
+}
+
+// chunkWriter publishes every frame of a followed log as a separate chunk
+type chunkWriter struct {
+	stream  string
+	publish func(chunk *api.LogChunk)
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	w.publish(&api.LogChunk{Stream: w.stream, Data: bytes.Clone(p)})
+	return len(p), nil
+}
+
+// logFollowers holds cancel funcs of followed logs by request id
+type logFollowers struct {
+	cancels map[string]context.CancelFunc
+	lock    sync.Mutex
+}
+
+func newLogFollowers() *logFollowers {
+	return &logFollowers{
+		cancels: make(map[string]context.CancelFunc),
+	}
+}
+
+func (f *logFollowers) add(requestId string, cancel context.CancelFunc) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.cancels[requestId]; ok {
+		return false
+	}
+	f.cancels[requestId] = cancel
+	return true
+}
+
+func (f *logFollowers) cancel(requestId string) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	cancel, ok := f.cancels[requestId]
+	if ok {
+		cancel()
+		delete(f.cancels, requestId)
+	}
+	return ok
+}
+
+func (f *logFollowers) remove(requestId string) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.cancels, requestId)
+}
+
+func logsOptions(options *api.LogOptions, follow bool) domain.AppLogsOptions {
+	logsOptions := domain.AppLogsOptions{
+		TailLines: max(options.GetTailLines(), 0),
+		Follow:    follow,
+	}
+	if options.GetSinceUnix() > 0 {
+		logsOptions.Since = time.Unix(options.GetSinceUnix(), 0)
+	}
+	return logsOptions
+}
+
+func (c *AppOperationAsyncServer) handleLogsApp(ctx context.Context, name string, options *api.LogOptions, requestId string) {
+	log.Printf("Retrieving logs of container: %s", name)
+
+	errorMessages := make([]string, 0)
+	maxBytes := int(options.GetMaxBytes())
+	if maxBytes <= 0 {
+		maxBytes = defaultLogMaxBytes
+	}
+	maxBytes = min(maxBytes, maxLogMaxBytes)
+	stdout := &cappedBuffer{limit: maxBytes}
+	stderr := &cappedBuffer{limit: maxBytes}
+
+	fetchedAt := time.Now()
+	err := c.copyLogs(ctx, name, logsOptions(options, false), stdout, stderr)
+	if err != nil {
+		log.Printf("Failed to retrieve logs: %v", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Failed to retrieve logs: %v", err))
+	}
+	if err == nil && options.GetFollow() {
+		err = c.followLogs(name, options, requestId, fetchedAt)
+		if err != nil {
+			log.Printf("Failed to follow logs: %v", err)
+			errorMessages = append(errorMessages, fmt.Sprintf("Failed to follow logs: %v", err))
+		}
+	}
+
+	response := api.LogsAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+		Stdout:        stdout.buf.Bytes(),
+		Stderr:        stderr.buf.Bytes(),
+		Truncated:     stdout.truncated || stderr.truncated,
+	}
+
+	c.publishResponse(ctx, &response, "logs_app", name, requestId)
+}
+
+func (c *AppOperationAsyncServer) handleCancelLogsApp(ctx context.Context, name string, options *api.LogOptions, requestId string) {
+	errorMessages := make([]string, 0)
+	var err error
+	if !c.followers.cancel(options.GetFollowRequestId()) {
+		err = fmt.Errorf("logs of %s are not followed under request id %s", name, options.GetFollowRequestId())
+		errorMessages = append(errorMessages, err.Error())
+	}
+
+	response := api.CancelLogsAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+	}
+
+	c.publishResponse(ctx, &response, "logs_cancel_app", name, requestId)
+}
+
+func (c *AppOperationAsyncServer) copyLogs(ctx context.Context, name string, options domain.AppLogsOptions, stdout, stderr io.Writer) error {
+	return c.runtime.Logs(ctx, name, options, stdout, stderr)
+}
+
+// followLogs streams new log lines to <response subject>.stream until cancelled,
+// the container stops or star shuts down. It runs outside of the executor,
+// so it doesn't hold a worker for as long as the log is followed.
+func (c *AppOperationAsyncServer) followLogs(name string, options *api.LogOptions, requestId string, since time.Time) error {
+	if requestId == "" {
+		return errors.New("following logs requires a request id")
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	if !c.followers.add(requestId, cancel) {
+		cancel()
+		return fmt.Errorf("logs are already followed under request id %s", requestId)
+	}
+
+	subject := c.nodeId + ".app_operation.logs_app." + name + "." + requestId + ".stream"
+	publish := func(chunk *api.LogChunk) {
+		chunk.Name = name
+		chunk.RequestId = requestId
+		data, err := proto.Marshal(chunk)
+		if err != nil {
+			log.Printf("Failed to marshal log chunk: %v", err)
+			return
+		}
+		err = c.publish(data, subject)
+		if err != nil {
+			log.Printf("Failed to publish log chunk to %s: %v", subject, err)
+		}
+	}
+
+	followOptions := logsOptions(options, true)
+	// lines logged before since were returned in the response
+	followOptions.TailLines = 0
+	followOptions.Since = since
+	go func() {
+		defer c.followers.remove(requestId)
+		defer cancel()
+		err := c.copyLogs(ctx, name, followOptions, chunkWriter{stream: "stdout", publish: publish}, chunkWriter{stream: "stderr", publish: publish})
+		last := &api.LogChunk{Last: true}
+		if err != nil && ctx.Err() == nil {
+			log.Printf("Failed to follow logs of %s: %v", name, err)
+			last.ErrorMessages = []string{fmt.Sprintf("Failed to follow logs: %v", err)}
+		}
+		publish(last)
+		log.Printf("Stopped following logs of %s (request id: %s)", name, requestId)
+	}()
+	return nil
+}
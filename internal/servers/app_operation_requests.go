@@ -0,0 +1,75 @@
+package servers
+
+import (
+	"sync"
+	"time"
+)
+
+// how long a response is kept for replaying to retried requests
+const operationResponseTTL = 10 * time.Minute
+
+type operationResponse struct {
+	subject string
+	data    []byte
+	expires time.Time
+}
+
+// operationRequests remembers request ids of in-flight and recently answered operations,
+// so a retried request is answered with the original response instead of being executed again
+type operationRequests struct {
+	inFlight  map[string]bool
+	responses map[string]operationResponse
+	lock      sync.Mutex
+}
+
+func newOperationRequests() *operationRequests {
+	return &operationRequests{
+		inFlight:  make(map[string]bool),
+		responses: make(map[string]operationResponse),
+	}
+}
+
+// begin returns false if the request is already being executed, or a cached
+// response if it was answered before. Requests without an id always begin.
+func (r *operationRequests) begin(requestId string) (bool, *operationResponse) {
+	if requestId == "" {
+		return true, nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if response, ok := r.responses[requestId]; ok && time.Now().Before(response.expires) {
+		return false, &response
+	}
+	if r.inFlight[requestId] {
+		return false, nil
+	}
+	r.inFlight[requestId] = true
+	return true, nil
+}
+
+// abort forgets an in-flight request that was never executed
+func (r *operationRequests) abort(requestId string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.inFlight, requestId)
+}
+
+func (r *operationRequests) finish(requestId, subject string, data []byte) {
+	if requestId == "" {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.inFlight, requestId)
+	now := time.Now()
+	for id, response := range r.responses {
+		if now.After(response.expires) {
+			delete(r.responses, id)
+		}
+	}
+	r.responses[requestId] = operationResponse{
+		subject: subject,
+		data:    data,
+		expires: now.Add(operationResponseTTL),
+	}
+}
@@ -0,0 +1,73 @@
+package servers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	proto_mapper "github.com/c12s/star/internal/mappers/proto"
+	"github.com/c12s/star/pkg/api"
+)
+
+func (c *AppOperationAsyncServer) handleStatsApp(ctx context.Context, name string, requestId string) {
+	log.Printf("Collecting stats of container: %s", name)
+
+	errorMessages := make([]string, 0)
+	stats, err := c.containerStats(ctx, name)
+	if err != nil {
+		log.Printf("Failed to collect stats: %v", err)
+		errorMessages = append(errorMessages, fmt.Sprintf("Failed to collect stats: %v", err))
+	}
+
+	response := api.StatsAppResp{
+		RequestId:     requestId,
+		Success:       err == nil,
+		ErrorMessages: errorMessages,
+		Stats:         stats,
+	}
+
+	c.publishResponse(ctx, &response, "stats_app", name, requestId)
+}
+
+// maxConcurrentStats bounds the stats streams a single request opens to the runtime
+const maxConcurrentStats = 8
+
+// collectStats collects stats of the apps concurrently, since runtimes
+// need two samples, about a second apart, to compute the CPU usage
+func (c *AppOperationAsyncServer) collectStats(ctx context.Context, apps []*api.NodeApp) ([]*api.AppStats, []string) {
+	stats := make([]*api.AppStats, len(apps))
+	errs := make([]error, len(apps))
+	wg := sync.WaitGroup{}
+	semaphore := make(chan struct{}, maxConcurrentStats)
+	for i, app := range apps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+			stats[i], errs[i] = c.containerStats(ctx, app.Name)
+		}()
+	}
+	wg.Wait()
+
+	collected := make([]*api.AppStats, 0, len(apps))
+	errorMessages := make([]string, 0)
+	for i := range apps {
+		if errs[i] != nil {
+			log.Printf("Failed to collect stats of %s: %v", apps[i].Name, errs[i])
+			errorMessages = append(errorMessages, fmt.Sprintf("Failed to collect stats of %s: %v", apps[i].Name, errs[i]))
+			continue
+		}
+		collected = append(collected, stats[i])
+	}
+	return collected, errorMessages
+}
+
+func (c *AppOperationAsyncServer) containerStats(ctx context.Context, name string) (*api.AppStats, error) {
+	stats, err := c.runtime.Stats(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return proto_mapper.AppStatsFromDomain(name, stats, c.supervisor.RestartCount(name)), nil
+}
@@ -0,0 +1,64 @@
+package servers
+
+import (
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// operationResult is implemented by every app operation response
+type operationResult interface {
+	GetSuccess() bool
+	GetErrorMessages() []string
+}
+
+// redactedValue replaces secrets in the audited requests
+const redactedValue = "<redacted>"
+
+// auditParameters encodes the request without its secrets, a request that can't be encoded is still audited
+func auditParameters(request proto.Message) string {
+	request = proto.Clone(request)
+	redactSecrets(request)
+	data, err := protojson.Marshal(request)
+	if err != nil {
+		log.Printf("Failed to encode audited request: %v", err)
+		return ""
+	}
+	return string(data)
+}
+
+// redactSecrets keeps only the names of the env variables of an app
+// and drops the password of a pre-stop hook URL
+func redactSecrets(request proto.Message) {
+	cmd, ok := request.(*api.AppOperationCommand)
+	if !ok {
+		return
+	}
+	for i, variable := range cmd.Env {
+		if name, _, ok := strings.Cut(variable, "="); ok {
+			cmd.Env[i] = name + "=" + redactedValue
+		}
+	}
+	if cmd.PreStopHook != nil && cmd.PreStopHook.HttpUrl != "" {
+		hookURL, err := url.Parse(cmd.PreStopHook.HttpUrl)
+		if err != nil {
+			cmd.PreStopHook.HttpUrl = redactedValue
+		} else {
+			cmd.PreStopHook.HttpUrl = hookURL.Redacted()
+		}
+	}
+}
+
+// appendAudit never fails the audited operation, a failed append is only logged
+func appendAudit(audit domain.AuditStore, entry domain.AuditEntry) {
+	entry.Time = time.Now()
+	if err := audit.Append(entry); err != nil {
+		log.Printf("Failed to append audit entry of %s %s: %s", entry.Operation, entry.Name, err.Message())
+	}
+}
@@ -0,0 +1,47 @@
+package servers
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/internal/services"
+	"github.com/c12s/star/pkg/api"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+func ConfigAckSubject(nodeId string) string {
+	return fmt.Sprintf("%s.config.ack", nodeId)
+}
+
+// configAcker publishes a signed ConfigAck for every config the node applies. The kuiper
+// and meridian clients ack the config deliveries themselves, without a way to sign them.
+type configAcker struct {
+	conn   *nats.Conn
+	signer domain.NodeSigner
+	nodeId string
+}
+
+func (a configAcker) ack(kind, name string, err error) {
+	ack := &api.ConfigAck{
+		NodeId:    a.nodeId,
+		Kind:      kind,
+		Name:      name,
+		Success:   err == nil,
+		Timestamp: time.Now().UnixNano(),
+	}
+	if err != nil {
+		ack.ErrorMessage = err.Error()
+	}
+	data, err := proto.Marshal(ack)
+	if err != nil {
+		log.Printf("Failed to marshal config ack: %v", err)
+		return
+	}
+	err = a.conn.PublishMsg(services.SignedMsg(a.signer, a.nodeId, ConfigAckSubject(a.nodeId), data))
+	if err != nil {
+		log.Printf("Failed to publish config ack of %s: %v", name, err)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/c12s/star/internal/domain"
 	proto_mapper "github.com/c12s/star/internal/mappers/proto"
 	"github.com/c12s/star/internal/services"
+	"github.com/nats-io/nats.go"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -17,10 +18,12 @@ type ConfigAsyncServer struct {
 	client  *kuiperapi.KuiperAsyncClient
 	configs domain.ConfigStore
 	serf    *services.SerfAgent
+	audit   domain.AuditStore
+	acker   configAcker
 	nodeId  string
 }
 
-func NewConfigAsyncServer(client *kuiperapi.KuiperAsyncClient, configs domain.ConfigStore, serf *services.SerfAgent, nodeId string) (*ConfigAsyncServer, error) {
+func NewConfigAsyncServer(client *kuiperapi.KuiperAsyncClient, configs domain.ConfigStore, serf *services.SerfAgent, audit domain.AuditStore, conn *nats.Conn, signer domain.NodeSigner, nodeId string) (*ConfigAsyncServer, error) {
 	if client == nil {
 		return nil, errors.New("client is nil")
 	}
@@ -28,13 +31,19 @@ func NewConfigAsyncServer(client *kuiperapi.KuiperAsyncClient, configs domain.Co
 		client:  client,
 		configs: configs,
 		serf:    serf,
+		audit:   audit,
+		acker:   configAcker{conn: conn, signer: signer, nodeId: nodeId},
 		nodeId:  nodeId,
 	}, nil
 }
 
 func (c *ConfigAsyncServer) Serve() {
 	err := c.client.ReceiveConfig(
-		func(protoConfig *kuiperapi.StandaloneConfig, namespace, strategy string) error {
+		func(protoConfig *kuiperapi.StandaloneConfig, namespace, strategy string) (err error) {
+			defer func() {
+				c.auditConfig("put_standalone_config", protoConfig.Name, protoConfig, err)
+				c.acker.ack("put_standalone_config", protoConfig.Name, err)
+			}()
 			config, err := proto_mapper.ApplyStandaloneConfigCommandToDomain(protoConfig, namespace)
 			if err != nil {
 				return err
@@ -53,7 +62,11 @@ func (c *ConfigAsyncServer) Serve() {
 			}
 			return nil
 		},
-		func(protoConfig *kuiperapi.ConfigGroup, namespace, strategy string) error {
+		func(protoConfig *kuiperapi.ConfigGroup, namespace, strategy string) (err error) {
+			defer func() {
+				c.auditConfig("put_config_group", protoConfig.Name, protoConfig, err)
+				c.acker.ack("put_config_group", protoConfig.Name, err)
+			}()
 			config, err := proto_mapper.ApplyConfigGroupCommandToDomain(protoConfig, namespace)
 			if err != nil {
 				return err
@@ -77,6 +90,21 @@ func (c *ConfigAsyncServer) Serve() {
 	}
 }
 
+func (c *ConfigAsyncServer) auditConfig(operation, name string, config proto.Message, err error) {
+	entry := domain.AuditEntry{
+		Kind:       domain.AuditKindConfig,
+		Operation:  operation,
+		Name:       name,
+		Subject:    "kuiper",
+		Parameters: auditParameters(config),
+		Success:    err == nil,
+	}
+	if err != nil {
+		entry.ErrorMessages = []string{err.Error()}
+	}
+	appendAudit(c.audit, entry)
+}
+
 func (c *ConfigAsyncServer) GracefulStop() {
 	c.client.GracefulStop()
 }
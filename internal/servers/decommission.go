@@ -0,0 +1,190 @@
+package servers
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/internal/services"
+	"github.com/c12s/star/pkg/api"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// NodeDeregistrationSubject is where magnetar is asked to drop the node, see NodeDeregistrationReq
+const NodeDeregistrationSubject = "magnetar.deregistration"
+
+// NodeDecommissioner retires the node on request from <nodeId>.decommission or the
+// StarNode Decommission RPC, onDecommissioned is called once the node id is wiped
+type NodeDecommissioner struct {
+	conn             *nats.Conn
+	apps             *AppOperationAsyncServer
+	serf             *services.SerfAgent
+	heartbeat        *services.HeartbeatService
+	nodeIdStore      domain.NodeIdStore
+	nodeKeyStore     domain.NodeKeyStore
+	signer           domain.NodeSigner
+	audit            domain.AuditStore
+	nodeId           string
+	timeout          time.Duration
+	onDecommissioned func()
+	lock             sync.Mutex
+	decommissioned   bool
+}
+
+func NewNodeDecommissioner(conn *nats.Conn, apps *AppOperationAsyncServer, serf *services.SerfAgent, heartbeat *services.HeartbeatService, nodeIdStore domain.NodeIdStore, nodeKeyStore domain.NodeKeyStore, signer domain.NodeSigner, audit domain.AuditStore, nodeId string, timeout time.Duration, onDecommissioned func()) *NodeDecommissioner {
+	return &NodeDecommissioner{
+		conn:             conn,
+		apps:             apps,
+		serf:             serf,
+		heartbeat:        heartbeat,
+		nodeIdStore:      nodeIdStore,
+		nodeKeyStore:     nodeKeyStore,
+		signer:           signer,
+		audit:            audit,
+		nodeId:           nodeId,
+		timeout:          timeout,
+		onDecommissioned: onDecommissioned,
+	}
+}
+
+func DecommissionSubject(nodeId string) string {
+	return fmt.Sprintf("%s.decommission", nodeId)
+}
+
+// Serve answers decommission requests sent over NATS with a DecommissionResp
+func (d *NodeDecommissioner) Serve() {
+	_, err := d.conn.Subscribe(DecommissionSubject(d.nodeId), func(msg *nats.Msg) {
+		req := &api.DecommissionReq{}
+		err := proto.Unmarshal(msg.Data, req)
+		if err != nil {
+			log.Printf("Failed to unmarshal decommission request: %v", err)
+			return
+		}
+		resp := d.Decommission(context.Background(), req, msg.Subject)
+		data, err := proto.Marshal(resp)
+		if err != nil {
+			log.Printf("Failed to marshal decommission response: %v", err)
+			return
+		}
+		err = msg.RespondMsg(services.SignedMsg(d.signer, d.nodeId, msg.Reply, data))
+		if err != nil {
+			log.Printf("Failed to respond to decommission request: %v", err)
+		}
+	})
+	if err != nil {
+		log.Println(err)
+	}
+}
+
+// Decommission drains the apps, deregisters the node from magnetar, leaves the cluster and
+// wipes its node id and key. The heartbeat is stopped first, so the node isn't registered again midway.
+// Only one decommission runs at a time and a failed one can be retried, when draining or
+// deregistering fails the heartbeat is restarted and apps are started again. A node id
+// that can't be wiped is reported, but the node is decommissioned all the same.
+func (d *NodeDecommissioner) Decommission(ctx context.Context, req *api.DecommissionReq, subject string) *api.DecommissionResp {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	resp := &api.DecommissionResp{
+		NodeId:        d.nodeId,
+		ErrorMessages: make([]string, 0),
+		DrainedApps:   make([]string, 0),
+	}
+	defer func() {
+		appendAudit(d.audit, domain.AuditEntry{
+			Kind:          domain.AuditKindNode,
+			Operation:     "decommission",
+			Name:          d.nodeId,
+			Subject:       subject,
+			Parameters:    auditParameters(req),
+			Success:       resp.Success,
+			ErrorMessages: resp.ErrorMessages,
+		})
+	}()
+	if d.decommissioned {
+		resp.ErrorMessages = append(resp.ErrorMessages, "node is already decommissioned")
+		return resp
+	}
+	log.Printf("Decommissioning node %s", d.nodeId)
+
+	d.heartbeat.Stop()
+
+	drained, errs := d.apps.Drain(ctx, domain.StopOptions{
+		Signal:      req.StopSignal,
+		GracePeriod: time.Duration(req.GracePeriodSeconds) * time.Second,
+	})
+	resp.DrainedApps = drained
+	if !d.proceed(resp, req.Force, errs...) {
+		d.resume()
+		return resp
+	}
+
+	// the node leaves the cluster only once magnetar dropped it, so a failed deregistration leaves it as it was
+	if !d.proceed(resp, req.Force, d.deregister()) {
+		d.resume()
+		return resp
+	}
+
+	d.serf.Leave()
+
+	// past this point the node can't be resumed, it is out of the cluster and magnetar dropped it,
+	// so it is decommissioned even if its id isn't wiped, a retry wouldn't get any further
+	err := d.nodeIdStore.Delete()
+	if err != nil {
+		// keeping the id would make the machine come back as the deregistered node, it has to be wiped by hand
+		log.Printf("Failed to wipe node id: %v", err)
+		resp.ErrorMessages = append(resp.ErrorMessages, fmt.Sprintf("Error wiping node id: %s", err))
+	}
+	// a key left behind is only reused by the next registration
+	keyErr := d.nodeKeyStore.Delete()
+	if keyErr != nil {
+		log.Printf("Failed to wipe node key: %v", keyErr)
+	}
+
+	d.decommissioned = true
+	resp.Success = err == nil
+	log.Printf("Node %s decommissioned", d.nodeId)
+	go d.onDecommissioned()
+	return resp
+}
+
+// resume undoes the steps before a failed one, the node keeps its heartbeat and starts apps again
+func (d *NodeDecommissioner) resume() {
+	log.Printf("Decommission of node %s failed, resuming the node", d.nodeId)
+	d.apps.Resume()
+	d.heartbeat.Restart()
+}
+
+// proceed records the errors of a step and reports whether the decommission goes on
+func (d *NodeDecommissioner) proceed(resp *api.DecommissionResp, force bool, errs ...error) bool {
+	failed := false
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed = true
+		log.Printf("Decommission step failed: %v", err)
+		resp.ErrorMessages = append(resp.ErrorMessages, err.Error())
+	}
+	return !failed || force
+}
+
+func (d *NodeDecommissioner) deregister() error {
+	data, err := proto.Marshal(&api.NodeDeregistrationReq{NodeId: d.nodeId})
+	if err != nil {
+		return err
+	}
+	// signed like the heartbeat, so magnetar only drops the node on a request from the node itself
+	msg, err := d.conn.RequestMsg(services.SignedMsg(d.signer, d.nodeId, NodeDeregistrationSubject, data), d.timeout)
+	if err != nil {
+		return fmt.Errorf("deregistering from magnetar: %w", err)
+	}
+	err = proto.Unmarshal(msg.Data, &api.NodeDeregistrationResp{})
+	if err != nil {
+		return fmt.Errorf("invalid deregistration response: %w", err)
+	}
+	return nil
+}
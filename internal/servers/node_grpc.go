@@ -0,0 +1,84 @@
+package servers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/internal/mappers/proto"
+	"github.com/c12s/star/internal/services"
+	"github.com/c12s/star/pkg/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type starNodeServer struct {
+	api.UnimplementedStarNodeServer
+	executor     *services.OperationExecutor
+	audit        domain.AuditStore
+	decommission *NodeDecommissioner
+	resources    *services.ResourceTracker
+}
+
+func NewStarNodeServer(executor *services.OperationExecutor, audit domain.AuditStore, decommission *NodeDecommissioner, resources *services.ResourceTracker) (api.StarNodeServer, error) {
+	return &starNodeServer{
+		executor:     executor,
+		audit:        audit,
+		decommission: decommission,
+		resources:    resources,
+	}, nil
+}
+
+func (s *starNodeServer) GetOperationQueueStats(ctx context.Context, req *api.GetOperationQueueStatsReq) (*api.GetOperationQueueStatsResp, error) {
+	stats := s.executor.Stats()
+	return &api.GetOperationQueueStatsResp{
+		Workers:  int64(stats.Workers),
+		Capacity: int64(stats.Capacity),
+		Queued:   int64(stats.Queued),
+		Running:  int64(stats.Running),
+	}, nil
+}
+
+func (s *starNodeServer) QueryAuditLog(ctx context.Context, req *api.QueryAuditLogReq) (*api.QueryAuditLogResp, error) {
+	entries, err := s.audit.Query(proto.AuditQueryToDomain(req))
+	if err != nil {
+		return nil, mapError(err)
+	}
+	resp := &api.QueryAuditLogResp{
+		Entries: make([]*api.AuditEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, proto.AuditEntryFromDomain(entry))
+	}
+	return resp, nil
+}
+
+// Decommission isn't canceled with the call, a client going away mustn't leave the node half drained
+func (s *starNodeServer) Decommission(ctx context.Context, req *api.DecommissionReq) (*api.DecommissionResp, error) {
+	return s.decommission.Decommission(context.WithoutCancel(ctx), req, "StarNode/Decommission"), nil
+}
+
+func (s *starNodeServer) GetNodeResources(ctx context.Context, req *api.GetNodeResourcesReq) (*api.GetNodeResourcesResp, error) {
+	resources, err := s.resources.Resources(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	resp := &api.GetNodeResourcesResp{
+		Capacity:       proto.ResourcesFromDomain(resources.Capacity),
+		SystemReserved: proto.ResourcesFromDomain(resources.SystemReserved),
+		Reserved:       proto.ResourcesFromDomain(resources.Reserved),
+		Allocatable:    proto.ResourcesFromDomain(resources.Allocatable),
+		Apps:           make([]*api.AppReservation, 0, len(resources.Apps)),
+	}
+	for name, reserved := range resources.Apps {
+		resp.Apps = append(resp.Apps, &api.AppReservation{
+			Name:      name,
+			Namespace: reserved.Namespace,
+			Resources: proto.ResourcesFromDomain(reserved.Resources),
+		})
+	}
+	sort.Slice(resp.Apps, func(i, j int) bool {
+		return resp.Apps[i].Name < resp.Apps[j].Name
+	})
+	return resp, nil
+}
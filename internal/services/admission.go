@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"math"
+	"os"
+	"sync"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+// admissionTolerance absorbs the rounding of summed float amounts,
+// so an app that exactly fits isn't rejected
+const admissionTolerance = 1e-9
+
+// AppAdmission decides whether an app can be started with the resources it requests. The
+// resources have to fit into what is allocatable on the node and into what is left of the
+// quota of the app's namespace. Admissions are serialized and an admitted app holds its
+// reservation until its container carries it, so concurrent starts can't overcommit the node.
+type AppAdmission struct {
+	resources *ResourceTracker
+	quotas    map[string]domain.Resources
+	lock      sync.Mutex
+	pending   map[string]AppReservation
+}
+
+func NewAppAdmission(resources *ResourceTracker, quotas map[string]domain.Resources) *AppAdmission {
+	return &AppAdmission{
+		resources: resources,
+		quotas:    quotas,
+		pending:   make(map[string]AppReservation),
+	}
+}
+
+// Admit reserves the requested resources for the app until release is called, which is once
+// the app's container is started or starting it failed. The resources of an existing container
+// of the app don't count against it, the container is either reused or replaced.
+// An app requesting no resources always fits into the node, but not into a namespace that
+// already exceeds its quota. A rejected app gets a *domain.AdmissionError.
+func (a *AppAdmission) Admit(ctx context.Context, name, namespace string, requested domain.Resources) (func(), error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	resources, err := a.resources.Resources(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error accounting for the node resources: %s", err)
+	}
+	apps := resources.Apps
+	for pendingName, reservation := range a.pending {
+		if _, ok := apps[pendingName]; !ok {
+			apps[pendingName] = reservation
+		}
+	}
+	delete(apps, name)
+
+	if !requested.IsZero() {
+		reserved := domain.Resources{}
+		for _, app := range apps {
+			reserved = reserved.Add(app.Resources)
+		}
+		allocatable := resources.Capacity.Sub(reserved).Sub(resources.SystemReserved)
+		exceeded := exceededResources(requested, allocatable)
+		if len(exceeded) > 0 {
+			return nil, &domain.AdmissionError{
+				Reason:    domain.AdmissionInsufficientCapacity,
+				Namespace: namespace,
+				Exceeded:  exceeded,
+			}
+		}
+	}
+	if quota, ok := a.quotas[namespace]; ok {
+		used := NodeResources{Apps: apps}.ReservedIn(namespace)
+		exceeded := exceededResources(requested, quotaLeft(quota, used))
+		if len(exceeded) > 0 {
+			return nil, &domain.AdmissionError{
+				Reason:    domain.AdmissionNamespaceQuotaExceeded,
+				Namespace: namespace,
+				Exceeded:  exceeded,
+			}
+		}
+	}
+
+	a.pending[name] = AppReservation{Namespace: namespace, Resources: requested}
+	return func() {
+		a.release(name)
+	}, nil
+}
+
+// AdmitRestart admits a stopped app container again before it is restarted,
+// with the resources it was created with
+func (a *AppAdmission) AdmitRestart(ctx context.Context, app domain.AppContainer) (func(), error) {
+	reservation, ok := labeledReservation(app)
+	if !ok {
+		return func() {}, nil
+	}
+	return a.Admit(ctx, app.Name, reservation.Namespace, reservation.Resources)
+}
+
+// release refreshes the app in the index first, so its reservation is counted
+// from its container before the pending one is dropped
+func (a *AppAdmission) release(name string) {
+	ctx, cancel := context.WithTimeout(context.Background(), appIndexRefreshTimeout)
+	defer cancel()
+	_, err := a.resources.index.Refresh(ctx, name)
+	if err != nil && !errors.Is(err, domain.ErrAppNotFound) {
+		log.Printf("Failed to refresh %s after admitting it: %v", name, err)
+	}
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.pending, name)
+}
+
+func exceededResources(requested, available domain.Resources) []domain.ExceededResource {
+	amounts := []struct {
+		resource             string
+		requested, available float64
+	}{
+		{"cpu", requested.CPU, available.CPU},
+		{"memoryGB", requested.MemoryGB, available.MemoryGB},
+		{"diskGB", requested.DiskGB, available.DiskGB},
+	}
+	exceeded := make([]domain.ExceededResource, 0)
+	for _, amount := range amounts {
+		if amount.requested <= amount.available+admissionTolerance {
+			continue
+		}
+		exceeded = append(exceeded, domain.ExceededResource{
+			Resource:  amount.resource,
+			Requested: amount.requested,
+			Available: max(amount.available, 0),
+		})
+	}
+	return exceeded
+}
+
+// quotaLeft is what the namespace can still reserve, resources without a quota are unlimited.
+// It isn't clamped at zero, so no app fits into a namespace that already exceeds its quota,
+// e.g. after the quota was lowered, not even one requesting nothing.
+func quotaLeft(quota, used domain.Resources) domain.Resources {
+	left := domain.Resources{
+		CPU:      quota.CPU - used.CPU,
+		MemoryGB: quota.MemoryGB - used.MemoryGB,
+		DiskGB:   quota.DiskGB - used.DiskGB,
+	}
+	if quota.CPU == 0 {
+		left.CPU = math.Inf(1)
+	}
+	if quota.MemoryGB == 0 {
+		left.MemoryGB = math.Inf(1)
+	}
+	if quota.DiskGB == 0 {
+		left.DiskGB = math.Inf(1)
+	}
+	return left
+}
+
+// ReadNamespaceQuotas reads a JSON object of namespaces and the resources their apps can reserve
+// on the node, e.g. {"default": {"cpu": 4, "memoryGB": 8}}. A resource left out or zero isn't
+// limited, a namespace left out has no quota and a missing file has no quotas.
+func ReadNamespaceQuotas(path string) (map[string]domain.Resources, error) {
+	quotas := make(map[string]domain.Resources)
+	if path == "" {
+		return quotas, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return quotas, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(data, &quotas)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for namespace, quota := range quotas {
+		if !quota.Valid() {
+			return nil, fmt.Errorf("%s: quota of namespace %s can't be negative", path, namespace)
+		}
+	}
+	return quotas, nil
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+func namespacedApp(name, namespace, resources string) domain.AppContainer {
+	app := reservingApp(name, "running", resources)
+	app.Labels[domain.AppNamespaceLabel] = namespace
+	return app
+}
+
+func admissionError(t *testing.T, err error) *domain.AdmissionError {
+	t.Helper()
+	admissionErr := &domain.AdmissionError{}
+	if !errors.As(err, &admissionErr) {
+		t.Fatalf("err = %v, want an admission error", err)
+	}
+	return admissionErr
+}
+
+func TestAppAdmissionRejectsAppsExceedingAllocatable(t *testing.T) {
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 4, MemoryGB: 8},
+		domain.Resources{MemoryGB: 1},
+		reservingApp("web", "running", `{"cpu":2,"memoryGB":4}`),
+	)
+	admission := NewAppAdmission(tracker, nil)
+
+	_, err := admission.Admit(context.Background(), "db", "", domain.Resources{CPU: 1, MemoryGB: 4})
+
+	rejection := admissionError(t, err)
+	if rejection.Reason != domain.AdmissionInsufficientCapacity {
+		t.Fatalf("reason = %s", rejection.Reason)
+	}
+	want := []domain.ExceededResource{{Resource: "memoryGB", Requested: 4, Available: 3}}
+	if len(rejection.Exceeded) != 1 || rejection.Exceeded[0] != want[0] {
+		t.Fatalf("exceeded = %+v, want %+v", rejection.Exceeded, want)
+	}
+}
+
+func TestAppAdmissionDoesNotCountTheAppAgainstItself(t *testing.T) {
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 4},
+		domain.Resources{},
+		reservingApp("web", "running", `{"cpu":3}`),
+	)
+	admission := NewAppAdmission(tracker, nil)
+
+	release, err := admission.Admit(context.Background(), "web", "", domain.Resources{CPU: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+}
+
+func TestAppAdmissionHoldsReservationsUntilReleased(t *testing.T) {
+	admission := NewAppAdmission(newTestResourceTracker(domain.Resources{CPU: 4}, domain.Resources{}), nil)
+
+	release, err := admission.Admit(context.Background(), "web", "", domain.Resources{CPU: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = admission.Admit(context.Background(), "db", "", domain.Resources{CPU: 2})
+	admissionError(t, err)
+
+	release()
+	_, err = admission.Admit(context.Background(), "db", "", domain.Resources{CPU: 2})
+	if err != nil {
+		t.Fatalf("admitting after the release: %v", err)
+	}
+}
+
+func TestAppAdmissionEnforcesNamespaceQuotas(t *testing.T) {
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 16, MemoryGB: 64},
+		domain.Resources{},
+		namespacedApp("web", "team-a", `{"cpu":3,"memoryGB":8}`),
+		namespacedApp("db", "team-b", `{"cpu":4}`),
+	)
+	admission := NewAppAdmission(tracker, map[string]domain.Resources{"team-a": {CPU: 4}})
+
+	_, err := admission.Admit(context.Background(), "worker", "team-a", domain.Resources{CPU: 2, MemoryGB: 32})
+
+	rejection := admissionError(t, err)
+	if rejection.Reason != domain.AdmissionNamespaceQuotaExceeded || rejection.Namespace != "team-a" {
+		t.Fatalf("rejection = %+v, want the team-a quota exceeded", rejection)
+	}
+	want := domain.ExceededResource{Resource: "cpu", Requested: 2, Available: 1}
+	if len(rejection.Exceeded) != 1 || rejection.Exceeded[0] != want {
+		t.Fatalf("exceeded = %+v, want %+v", rejection.Exceeded, want)
+	}
+
+	if _, err := admission.Admit(context.Background(), "worker", "team-b", domain.Resources{CPU: 2}); err != nil {
+		t.Fatalf("admitting to a namespace without a quota: %v", err)
+	}
+}
+
+func TestReadNamespaceQuotas(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotas.json")
+	quotas, err := ReadNamespaceQuotas(path)
+	if err != nil || len(quotas) != 0 {
+		t.Fatalf("quotas = %v, %v, want none for a missing file", quotas, err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"default": {"cpu": 2, "memoryGB": 4}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	quotas, err = ReadNamespaceQuotas(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (domain.Resources{CPU: 2, MemoryGB: 4}); quotas["default"] != want {
+		t.Fatalf("default quota = %+v, want %+v", quotas["default"], want)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"default": {"cpu": -1}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadNamespaceQuotas(path); err == nil {
+		t.Fatal("expected an error for a negative quota")
+	}
+}
+
+func TestAppAdmissionAdmitsRestartsAgain(t *testing.T) {
+	exited := reservingApp("web", "exited", `{"cpu":3}`)
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 4},
+		domain.Resources{},
+		exited,
+		reservingApp("db", "running", `{"cpu":2}`),
+	)
+	admission := NewAppAdmission(tracker, nil)
+
+	_, err := admission.AdmitRestart(context.Background(), exited)
+
+	rejection := admissionError(t, err)
+	want := domain.ExceededResource{Resource: "cpu", Requested: 3, Available: 2}
+	if len(rejection.Exceeded) != 1 || rejection.Exceeded[0] != want {
+		t.Fatalf("exceeded = %+v, want %+v", rejection.Exceeded, want)
+	}
+}
+
+func TestAppAdmissionChecksQuotasOfAppsRequestingNothing(t *testing.T) {
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 2},
+		domain.Resources{},
+		namespacedApp("web", "team-a", `{"cpu":3}`),
+	)
+	admission := NewAppAdmission(tracker, map[string]domain.Resources{"team-a": {CPU: 2}})
+
+	_, err := admission.Admit(context.Background(), "worker", "team-a", domain.Resources{})
+
+	rejection := admissionError(t, err)
+	if rejection.Reason != domain.AdmissionNamespaceQuotaExceeded {
+		t.Fatalf("rejection = %+v, want the team-a quota exceeded", rejection)
+	}
+	release, err := admission.Admit(context.Background(), "worker", "team-b", domain.Resources{})
+	if err != nil {
+		t.Fatalf("admitting an app requesting nothing to a node without capacity left: %v", err)
+	}
+	release()
+}
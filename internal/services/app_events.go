@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	proto_mapper "github.com/c12s/star/internal/mappers/proto"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	minEventStreamBackoff = time.Second
+	maxEventStreamBackoff = 30 * time.Second
+)
+
+// AppEventWatcher follows the runtime's event stream for app containers, publishes normalized
+// events on the node's NATS subject and passes them to local listeners
+type AppEventWatcher struct {
+	runtime   domain.AppRuntime
+	conn      *nats.Conn
+	nodeId    string
+	listeners []func(event domain.AppEvent)
+	ctx       context.Context
+	cancel    context.CancelFunc
+	Wg        sync.WaitGroup
+}
+
+func NewAppEventWatcher(runtime domain.AppRuntime, conn *nats.Conn, nodeId string) *AppEventWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &AppEventWatcher{
+		runtime:   runtime,
+		conn:      conn,
+		nodeId:    nodeId,
+		listeners: make([]func(event domain.AppEvent), 0),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// AddListener registers a local callback, it must be called before Watch
+func (w *AppEventWatcher) AddListener(listener func(event domain.AppEvent)) {
+	w.listeners = append(w.listeners, listener)
+}
+
+// Watch blocks until Stop is called, the event stream is reopened with backoff whenever it fails
+func (w *AppEventWatcher) Watch() {
+	defer w.Wg.Done()
+	backoff := minEventStreamBackoff
+	since := time.Now()
+	for {
+		appEvents, errs := w.runtime.Events(w.ctx, since)
+		err := w.consume(appEvents, errs, &since, &backoff)
+		if w.ctx.Err() != nil {
+			log.Println("app event watcher stopped")
+			return
+		}
+		log.Printf("app event stream closed: %v, reconnecting in %s", err, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-w.ctx.Done():
+			log.Println("app event watcher stopped")
+			return
+		}
+		backoff = min(backoff*2, maxEventStreamBackoff)
+	}
+}
+
+func (w *AppEventWatcher) consume(appEvents <-chan domain.AppEvent, errs <-chan error, since *time.Time, backoff *time.Duration) error {
+	for {
+		select {
+		case event, ok := <-appEvents:
+			if !ok {
+				return errors.New("event stream ended")
+			}
+			*backoff = minEventStreamBackoff
+			// the runtime streams events from since on, including events of that very time
+			if !event.Time.Before(*since) {
+				*since = event.Time.Add(time.Nanosecond)
+			}
+			w.publish(event)
+			for _, listener := range w.listeners {
+				listener(event)
+			}
+		case err := <-errs:
+			return err
+		}
+	}
+}
+
+func (w *AppEventWatcher) publish(event domain.AppEvent) {
+	data, err := proto.Marshal(proto_mapper.AppEventFromDomain(w.nodeId, event))
+	if err != nil {
+		log.Printf("Failed to marshal app event: %v", err)
+		return
+	}
+	subject := AppEventSubject(w.nodeId, event.Type, event.Name)
+	err = w.conn.Publish(subject, data)
+	if err != nil {
+		log.Printf("Failed to publish app event to %s: %v", subject, err)
+	}
+}
+
+func (w *AppEventWatcher) Stop() {
+	w.cancel()
+	w.Wg.Wait()
+}
+
+// AppEventSubject returns <nodeId>.app_event.<type>.<name>, consumers can subscribe to <nodeId>.app_event.>
+func AppEventSubject(nodeId string, eventType domain.AppEventType, name string) string {
+	return fmt.Sprintf("%s.app_event.%s.%s", nodeId, eventType, name)
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// AppGarbageCollector removes stopped app containers whose revision has not been
+// referenced for longer than the retention period. Removals go through the executor,
+// so they are ordered with the operations on the app, and removed apps stop being supervised.
+type AppGarbageCollector struct {
+	runtime        domain.AppRuntime
+	executor       *OperationExecutor
+	supervisor     *AppSupervisor
+	conn           *nats.Conn
+	nodeId         string
+	interval       time.Duration
+	retention      time.Duration
+	lastReferenced map[string]time.Time
+	lock           sync.Mutex
+	stopChannel    chan struct{}
+	Wg             sync.WaitGroup
+}
+
+func NewAppGarbageCollector(runtime domain.AppRuntime, executor *OperationExecutor, supervisor *AppSupervisor, conn *nats.Conn, nodeId string, interval, retention time.Duration) *AppGarbageCollector {
+	return &AppGarbageCollector{
+		runtime:        runtime,
+		executor:       executor,
+		supervisor:     supervisor,
+		conn:           conn,
+		nodeId:         nodeId,
+		interval:       interval,
+		retention:      retention,
+		lastReferenced: make(map[string]time.Time),
+		stopChannel:    make(chan struct{}),
+	}
+}
+
+// Reference marks the revision as in use, postponing collection of its stopped containers
+func (gc *AppGarbageCollector) Reference(revision string) {
+	if revision == "" {
+		return
+	}
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+	gc.lastReferenced[revision] = time.Now()
+}
+
+func (gc *AppGarbageCollector) Run() {
+	defer gc.Wg.Done()
+	ticker := time.NewTicker(gc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			gc.Collect(context.Background())
+		case <-gc.stopChannel:
+			log.Println("app garbage collector stopped")
+			return
+		}
+	}
+}
+
+func (gc *AppGarbageCollector) Stop() {
+	close(gc.stopChannel)
+	gc.Wg.Wait()
+}
+
+// Collect runs a single collection pass and publishes a report if anything was removed or failed
+func (gc *AppGarbageCollector) Collect(ctx context.Context) {
+	report := &api.AppGarbageCollectionReport{
+		NodeId:        gc.nodeId,
+		Removed:       make([]*api.RemovedApp, 0),
+		ErrorMessages: make([]string, 0),
+		Timestamp:     time.Now().UnixNano(),
+	}
+
+	containers, err := gc.runtime.List(ctx)
+	if err != nil {
+		log.Printf("Failed to list containers for garbage collection: %v", err)
+		return
+	}
+
+	stopped := make([]domain.AppContainer, 0)
+	for _, c := range containers {
+		if isStoppedState(c.State) {
+			stopped = append(stopped, c)
+		} else {
+			gc.Reference(c.Labels[domain.AppRevisionLabel])
+		}
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	for _, c := range stopped {
+		revision := c.Labels[domain.AppRevisionLabel]
+		if !gc.expired(revision) {
+			continue
+		}
+		wg.Add(1)
+		err := gc.executor.Submit(c.Name, "gc", 0, func(ctx context.Context) {
+			defer wg.Done()
+			removed, err := gc.remove(ctx, c)
+			if removed {
+				gc.supervisor.Untrack(c.Name)
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				log.Printf("Failed to remove container %s: %v", c.Name, err)
+				report.ErrorMessages = append(report.ErrorMessages, fmt.Sprintf("Failed to remove container %s: %v", c.Name, err))
+				return
+			}
+			if removed {
+				log.Printf("Garbage collected container %s of revision %s", c.Name, revision)
+				report.Removed = append(report.Removed, &api.RemovedApp{ContainerId: c.Id, Name: c.Name, Revision: revision})
+			}
+		})
+		if err != nil {
+			wg.Done()
+			lock.Lock()
+			report.ErrorMessages = append(report.ErrorMessages, fmt.Sprintf("Failed to remove container %s: %v", c.Name, err))
+			lock.Unlock()
+		}
+	}
+	wg.Wait()
+
+	// a revision is remembered while any of its containers is left
+	remaining := make(map[string]bool)
+	for _, c := range containers {
+		if !containsRemoved(report.Removed, c.Id) {
+			remaining[c.Labels[domain.AppRevisionLabel]] = true
+		}
+	}
+	gc.prune(remaining)
+
+	if len(report.Removed) == 0 && len(report.ErrorMessages) == 0 {
+		return
+	}
+	data, err := proto.Marshal(report)
+	if err != nil {
+		log.Printf("Failed to marshal garbage collection report: %v", err)
+		return
+	}
+	err = gc.conn.Publish(AppGarbageCollectionSubject(gc.nodeId), data)
+	if err != nil {
+		log.Printf("Failed to publish garbage collection report: %v", err)
+	}
+}
+
+// remove removes the container unless it was started or replaced since it was listed
+func (gc *AppGarbageCollector) remove(ctx context.Context, c domain.AppContainer) (bool, error) {
+	current, err := gc.runtime.Inspect(ctx, c.Name)
+	if errors.Is(err, domain.ErrAppNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if current.Id != c.Id || !isStoppedState(current.State) {
+		return false, nil
+	}
+	err = gc.runtime.Remove(ctx, c.Name)
+	if errors.Is(err, domain.ErrAppNotFound) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// prune forgets expired revisions that have no containers left, so they don't pile up
+func (gc *AppGarbageCollector) prune(remaining map[string]bool) {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+	for revision, lastReferenced := range gc.lastReferenced {
+		if !remaining[revision] && time.Since(lastReferenced) >= gc.retention {
+			delete(gc.lastReferenced, revision)
+		}
+	}
+}
+
+func containsRemoved(removed []*api.RemovedApp, containerId string) bool {
+	for _, app := range removed {
+		if app.ContainerId == containerId {
+			return true
+		}
+	}
+	return false
+}
+
+// expired starts the retention clock for revisions seen for the first time
+func (gc *AppGarbageCollector) expired(revision string) bool {
+	gc.lock.Lock()
+	defer gc.lock.Unlock()
+	lastReferenced, ok := gc.lastReferenced[revision]
+	if !ok {
+		gc.lastReferenced[revision] = time.Now()
+		return false
+	}
+	return time.Since(lastReferenced) >= gc.retention
+}
+
+func AppGarbageCollectionSubject(nodeId string) string {
+	return fmt.Sprintf("%s.app_gc", nodeId)
+}
+
+func isStoppedState(state string) bool {
+	return state == "created" || state == "exited" || state == "dead"
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"log"
+	"maps"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+const appIndexRefreshTimeout = 10 * time.Second
+
+type indexedApp struct {
+	app     domain.AppContainer
+	updated time.Time
+}
+
+// AppIndex keeps the state of app containers in memory, it is updated from app events
+// and periodically resynced with the runtime to correct anything the events missed
+type AppIndex struct {
+	runtime     domain.AppRuntime
+	interval    time.Duration
+	apps        map[string]*indexedApp
+	lock        sync.RWMutex
+	stopChannel chan struct{}
+	Wg          sync.WaitGroup
+}
+
+func NewAppIndex(runtime domain.AppRuntime, interval time.Duration) *AppIndex {
+	return &AppIndex{
+		runtime:     runtime,
+		interval:    interval,
+		apps:        make(map[string]*indexedApp),
+		stopChannel: make(chan struct{}),
+	}
+}
+
+func (i *AppIndex) Run() {
+	defer i.Wg.Done()
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := i.Resync(context.Background()); err != nil {
+				log.Printf("Failed to resync app index: %v", err)
+			}
+		case <-i.stopChannel:
+			log.Println("app index stopped")
+			return
+		}
+	}
+}
+
+func (i *AppIndex) Stop() {
+	close(i.stopChannel)
+	i.Wg.Wait()
+}
+
+// Resync replaces the index with the containers the runtime reports, apps
+// updated by events while the resync was running are left as they are
+func (i *AppIndex) Resync(ctx context.Context) error {
+	started := time.Now()
+	containers, err := i.runtime.List(ctx)
+	if err != nil {
+		return err
+	}
+	apps := make(map[string]domain.AppContainer)
+	for _, app := range containers {
+		apps[app.Name] = app
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	for name, app := range apps {
+		if existing, ok := i.apps[name]; ok && existing.updated.After(started) {
+			continue
+		}
+		i.apps[name] = &indexedApp{app: app, updated: started}
+	}
+	for name, existing := range i.apps {
+		if _, ok := apps[name]; !ok && !existing.updated.After(started) {
+			delete(i.apps, name)
+		}
+	}
+	return nil
+}
+
+// Refresh inspects a single container and updates its entry right away,
+// instead of waiting for its events
+func (i *AppIndex) Refresh(ctx context.Context, name string) (domain.AppContainer, error) {
+	app, err := i.runtime.Inspect(ctx, name)
+	if err != nil {
+		return domain.AppContainer{}, err
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.apps[app.Name] = &indexedApp{app: app, updated: time.Now()}
+	return copyApp(app), nil
+}
+
+// refreshDetails fills in what events don't carry, unless the container was replaced since
+func (i *AppIndex) refreshDetails(id string) {
+	ctx, cancel := context.WithTimeout(context.Background(), appIndexRefreshTimeout)
+	defer cancel()
+	app, err := i.runtime.Inspect(ctx, id)
+	if err != nil {
+		log.Printf("Failed to inspect container %s: %v", id, err)
+		return
+	}
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if indexed, ok := i.apps[app.Name]; ok && indexed.app.Id == id {
+		indexed.app.Image = app.Image
+		indexed.app.ImageDigest = app.ImageDigest
+		indexed.app.IPAddresses = app.IPAddresses
+		indexed.app.Ports = app.Ports
+	}
+}
+
+// OnEvent is registered as an app event listener
+func (i *AppIndex) OnEvent(event domain.AppEvent) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if event.Type == domain.AppEventRemoved {
+		delete(i.apps, event.Name)
+		return
+	}
+	indexed, ok := i.apps[event.Name]
+	if !ok || indexed.app.Id != event.ContainerId {
+		indexed = &indexedApp{app: domain.AppContainer{Id: event.ContainerId, Name: event.Name}}
+		i.apps[event.Name] = indexed
+	}
+	indexed.updated = time.Now()
+	app := &indexed.app
+	if len(event.Labels) > 0 {
+		app.Labels = event.Labels
+	}
+	switch event.Type {
+	case domain.AppEventStarted:
+		app.State = "running"
+		app.Running = true
+		app.Paused = false
+		app.StartedAt = event.Time
+		app.FinishedAt = time.Time{}
+		// the health check starts over with the container
+		app.Health = ""
+		go i.refreshDetails(event.ContainerId)
+	case domain.AppEventDied:
+		app.State = "exited"
+		app.Running = false
+		app.Paused = false
+		app.ExitCode = event.ExitCode
+		app.FinishedAt = event.Time
+	case domain.AppEventPaused:
+		app.State = "paused"
+		app.Paused = true
+	case domain.AppEventUnpaused:
+		app.State = "running"
+		app.Paused = false
+	case domain.AppEventHealthStatusChanged:
+		app.Health = event.HealthStatus
+	}
+}
+
+func (i *AppIndex) Get(name string) (domain.AppContainer, bool) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	indexed, ok := i.apps[name]
+	if !ok {
+		return domain.AppContainer{}, false
+	}
+	return copyApp(indexed.app), true
+}
+
+// List returns running apps with revision=<prefix> matching the selector, sorted by name.
+// A selector with its own requirement on the revision label replaces the prefix filter.
+func (i *AppIndex) List(prefix string, selector domain.LabelSelector) ([]domain.AppContainer, error) {
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	apps := make([]domain.AppContainer, 0)
+	for _, indexed := range i.apps {
+		app := indexed.app
+		if !app.Running || !selector.Matches(app.Labels) {
+			continue
+		}
+		if !selector.Constrains(domain.AppRevisionLabel) && app.Labels[domain.AppRevisionLabel] != prefix {
+			continue
+		}
+		apps = append(apps, copyApp(app))
+	}
+	sort.Slice(apps, func(a, b int) bool {
+		return apps[a].Name < apps[b].Name
+	})
+	return apps, nil
+}
+
+// All returns every indexed app, stopped ones included
+func (i *AppIndex) All() []domain.AppContainer {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	apps := make([]domain.AppContainer, 0, len(i.apps))
+	for _, indexed := range i.apps {
+		apps = append(apps, copyApp(indexed.app))
+	}
+	return apps
+}
+
+func (i *AppIndex) RunningCount() int {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	count := 0
+	for _, indexed := range i.apps {
+		if indexed.app.Running {
+			count++
+		}
+	}
+	return count
+}
+
+func copyApp(app domain.AppContainer) domain.AppContainer {
+	app.Labels = maps.Clone(app.Labels)
+	app.IPAddresses = slices.Clone(app.IPAddresses)
+	app.Ports = slices.Clone(app.Ports)
+	return app
+}
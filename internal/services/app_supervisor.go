@@ -0,0 +1,234 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+// a container that stayed up this long before dying starts its backoff from scratch
+const restartBackoffResetWindow = 10 * time.Minute
+
+type supervisedApp struct {
+	policy    domain.RestartPolicy
+	restarts  int64
+	failures  int64
+	startedAt time.Time
+	timer     *time.Timer
+	// restarting is set while the container is restarted on request, so its exit isn't handled as a failure
+	restarting bool
+}
+
+// AppSupervisor restarts app containers started by star when they exit,
+// according to the restart policy given when they were started. Restarts go
+// through the executor, so they are ordered with the operations on the app, and are
+// admitted again since an exited container doesn't reserve resources.
+type AppSupervisor struct {
+	runtime   domain.AppRuntime
+	executor  *OperationExecutor
+	admission *AppAdmission
+	apps      map[string]*supervisedApp
+	lock      sync.Mutex
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func NewAppSupervisor(runtime domain.AppRuntime, executor *OperationExecutor, admission *AppAdmission) *AppSupervisor {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &AppSupervisor{
+		runtime:   runtime,
+		executor:  executor,
+		admission: admission,
+		apps:      make(map[string]*supervisedApp),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Track starts supervising the container, tracking it again resets its restart count
+func (s *AppSupervisor) Track(name string, policy domain.RestartPolicy) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if app, ok := s.apps[name]; ok && app.timer != nil {
+		app.timer.Stop()
+	}
+	s.apps[name] = &supervisedApp{
+		policy:    policy,
+		startedAt: time.Now(),
+	}
+}
+
+// Restore supervises the running apps again after star restarted, with the restart policy
+// stored in their AppRestartPolicyLabel. Stopped apps aren't, they may have been stopped on purpose.
+func (s *AppSupervisor) Restore(apps []domain.AppContainer) {
+	for _, app := range apps {
+		data, ok := app.Labels[domain.AppRestartPolicyLabel]
+		if !ok || !app.Running {
+			continue
+		}
+		policy := domain.RestartPolicy{}
+		err := json.Unmarshal([]byte(data), &policy)
+		if err != nil {
+			log.Printf("Failed to decode the restart policy of %s: %v", app.Name, err)
+			continue
+		}
+		s.lock.Lock()
+		_, tracked := s.apps[app.Name]
+		if !tracked {
+			s.apps[app.Name] = &supervisedApp{
+				policy:    policy,
+				startedAt: app.StartedAt,
+			}
+		}
+		s.lock.Unlock()
+		if !tracked {
+			log.Printf("Supervising %s again (policy: %s)", app.Name, policy.Mode)
+		}
+	}
+}
+
+// Untrack must be called before a container is stopped on purpose, so it isn't brought back up
+func (s *AppSupervisor) Untrack(name string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if app, ok := s.apps[name]; ok && app.timer != nil {
+		app.timer.Stop()
+	}
+	delete(s.apps, name)
+}
+
+// Restarting must be called before a container is restarted on purpose and again
+// with false if the restart failed, a successful restart is noticed from its start event
+func (s *AppSupervisor) Restarting(name string, restarting bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if app, ok := s.apps[name]; ok {
+		app.restarting = restarting
+	}
+}
+
+func (s *AppSupervisor) RestartCount(name string) int64 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if app, ok := s.apps[name]; ok {
+		return app.restarts
+	}
+	return 0
+}
+
+// OnEvent is registered as an app event listener
+func (s *AppSupervisor) OnEvent(event domain.AppEvent) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	app, ok := s.apps[event.Name]
+	if !ok {
+		return
+	}
+	switch event.Type {
+	case domain.AppEventStarted:
+		app.startedAt = event.Time
+		app.restarting = false
+	case domain.AppEventDied:
+		if app.restarting {
+			return
+		}
+		if app.timer != nil {
+			// a restart is already scheduled
+			return
+		}
+		if event.Time.Sub(app.startedAt) >= restartBackoffResetWindow {
+			app.failures = 0
+		}
+		if !app.policy.ShouldRestart(event.ExitCode, app.failures) {
+			log.Printf("Container %s exited with code %d, not restarting it (policy: %s, failures: %d)", event.Name, event.ExitCode, app.policy.Mode, app.failures)
+			return
+		}
+		backoff := app.policy.Backoff(app.failures)
+		log.Printf("Container %s exited with code %d, restarting in %s", event.Name, event.ExitCode, backoff)
+		s.scheduleRestart(event.Name, app, event.ExitCode, backoff)
+	case domain.AppEventRemoved:
+		// removed without being stopped through star, e.g. by hand, so there is nothing left to restart
+		if app.timer != nil {
+			app.timer.Stop()
+		}
+		delete(s.apps, event.Name)
+		log.Printf("Container %s was removed, not supervising it anymore", event.Name)
+	}
+}
+
+// scheduleRestart must be called with the lock held
+func (s *AppSupervisor) scheduleRestart(name string, app *supervisedApp, exitCode int64, backoff time.Duration) {
+	app.timer = time.AfterFunc(backoff, func() {
+		s.restart(name, app, exitCode)
+	})
+}
+
+func (s *AppSupervisor) restart(name string, app *supervisedApp, exitCode int64) {
+	err := s.executor.Submit(name, "supervisor_restart", 0, func(ctx context.Context) {
+		// checked once the operations queued before the restart ran, e.g. a stop untracks the app
+		s.lock.Lock()
+		tracked := s.apps[name] == app
+		s.lock.Unlock()
+		if !tracked {
+			return
+		}
+		s.restarted(name, app, exitCode, s.start(ctx, name))
+	})
+	if err != nil {
+		s.restarted(name, app, exitCode, err)
+	}
+}
+
+func (s *AppSupervisor) start(ctx context.Context, name string) error {
+	container, err := s.runtime.Inspect(ctx, name)
+	if err != nil {
+		return err
+	}
+	release, err := s.admission.AdmitRestart(ctx, container)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return s.runtime.Start(ctx, name)
+}
+
+func (s *AppSupervisor) restarted(name string, app *supervisedApp, exitCode int64, err error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	app.timer = nil
+	if s.apps[name] != app {
+		// untracked or tracked again while the restart was pending
+		return
+	}
+	app.failures++
+	if errors.Is(err, domain.ErrAppNotFound) {
+		log.Printf("Container %s to restart was removed, not supervising it anymore", name)
+		delete(s.apps, name)
+		return
+	}
+	if err != nil {
+		log.Printf("Failed to restart container %s: %v", name, err)
+		if s.ctx.Err() == nil && !errors.Is(err, ErrExecutorStopped) && app.policy.ShouldRestart(exitCode, app.failures) {
+			s.scheduleRestart(name, app, exitCode, app.policy.Backoff(app.failures))
+		}
+		return
+	}
+	app.restarts++
+	log.Printf("Container %s restarted (restart count: %d)", name, app.restarts)
+}
+
+func (s *AppSupervisor) Stop() {
+	s.cancel()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, app := range s.apps {
+		if app.timer != nil {
+			app.timer.Stop()
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+func newTestAppSupervisor(runtime *fakeAppRuntime) (*AppSupervisor, *OperationExecutor) {
+	executor := NewOperationExecutor(1, 10, time.Minute)
+	executor.Start()
+	return NewAppSupervisor(runtime, executor, nil), executor
+}
+
+func alwaysRestart(backoff time.Duration) domain.RestartPolicy {
+	return domain.RestartPolicy{
+		Mode:           domain.RestartPolicyAlways,
+		InitialBackoff: backoff,
+		MaxBackoff:     backoff,
+	}
+}
+
+func supervised(s *AppSupervisor, name string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.apps[name]
+	return ok
+}
+
+func TestAppSupervisorForgetsRemovedApps(t *testing.T) {
+	supervisor, executor := newTestAppSupervisor(&fakeAppRuntime{})
+	defer executor.Stop()
+	defer supervisor.Stop()
+	supervisor.Track("web", alwaysRestart(time.Hour))
+
+	supervisor.OnEvent(domain.AppEvent{Type: domain.AppEventDied, Name: "web", ExitCode: 1, Time: time.Now()})
+	supervisor.OnEvent(domain.AppEvent{Type: domain.AppEventRemoved, Name: "web", Time: time.Now()})
+
+	if supervised(supervisor, "web") {
+		t.Fatal("web is still supervised after it was removed")
+	}
+}
+
+func TestAppSupervisorForgetsAppsMissingOnRestart(t *testing.T) {
+	// the runtime doesn't know the app, as if it was removed without a removed event
+	supervisor, executor := newTestAppSupervisor(&fakeAppRuntime{})
+	defer executor.Stop()
+	defer supervisor.Stop()
+	supervisor.Track("web", alwaysRestart(time.Millisecond))
+
+	supervisor.OnEvent(domain.AppEvent{Type: domain.AppEventDied, Name: "web", ExitCode: 1, Time: time.Now()})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for supervised(supervisor, "web") {
+		if time.Now().After(deadline) {
+			t.Fatal("web is still supervised after its restart found it missing")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,325 @@
+package services
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// cgroupV1UnlimitedBytes is the smallest memory.limit_in_bytes treated as no limit, cgroup v1
+// reports the largest page aligned int64 instead of a marker like the max of cgroup v2
+const cgroupV1UnlimitedBytes = 1 << 62
+
+// cgroupLimits are the limits of the cgroup star runs in, zero values mean no limit.
+// The version is 0 when star doesn't run in a cgroup, e.g. on other systems than linux.
+type cgroupLimits struct {
+	version     int
+	cpuQuota    float64
+	cpusetCores int
+	memoryLimit uint64
+}
+
+func readCgroupLimits() (cgroupLimits, error) {
+	paths, unifiedPath, err := readProcCgroup("/proc/self/cgroup")
+	if errors.Is(err, os.ErrNotExist) {
+		return cgroupLimits{}, nil
+	}
+	if err != nil {
+		return cgroupLimits{}, err
+	}
+	mounts, err := readMountInfo("/proc/self/mountinfo")
+	if err != nil {
+		return cgroupLimits{}, err
+	}
+	limits := cgroupLimits{}
+	v1, v2 := false, false
+	// controllers of a hybrid setup are read from their v1 hierarchy, the rest from the unified one
+	if dir, mountPoint, ok := cgroupV1Dir(mounts, paths, "cpu"); ok {
+		v1 = true
+		limits.cpuQuota = walkCgroup(dir, mountPoint, readCgroupV1CpuQuota)
+	} else if dir, mountPoint, ok := cgroupV2Dir(mounts, unifiedPath); ok {
+		v2 = true
+		limits.cpuQuota = walkCgroup(dir, mountPoint, readCgroupV2CpuQuota)
+	}
+	if dir, mountPoint, ok := cgroupV1Dir(mounts, paths, "memory"); ok {
+		v1 = true
+		limits.memoryLimit = walkCgroup(dir, mountPoint, readCgroupV1MemoryLimit)
+	} else if dir, mountPoint, ok := cgroupV2Dir(mounts, unifiedPath); ok {
+		v2 = true
+		limits.memoryLimit = walkCgroup(dir, mountPoint, readCgroupV2MemoryLimit)
+	}
+	// the cpus of a cpuset are a subset of the parent cpus, so only the own cpuset is read
+	if dir, _, ok := cgroupV1Dir(mounts, paths, "cpuset"); ok {
+		limits.cpusetCores = readCpusetCores(dir, "cpuset.effective_cpus", "cpuset.cpus")
+	} else if dir, _, ok := cgroupV2Dir(mounts, unifiedPath); ok {
+		limits.cpusetCores = readCpusetCores(dir, "cpuset.cpus.effective")
+	}
+	if v1 {
+		limits.version = 1
+	} else if v2 {
+		limits.version = 2
+	}
+	return limits, nil
+}
+
+// readProcCgroup returns the cgroup v1 path of each controller and the cgroup v2 path
+func readProcCgroup(path string) (map[string]string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+	paths := make(map[string]string)
+	unifiedPath := ""
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// hierarchy-id:controller-list:cgroup-path
+		fields := strings.SplitN(scanner.Text(), ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		if fields[0] == "0" && fields[1] == "" {
+			unifiedPath = fields[2]
+			continue
+		}
+		for _, controller := range strings.Split(fields[1], ",") {
+			paths[controller] = fields[2]
+		}
+	}
+	return paths, unifiedPath, scanner.Err()
+}
+
+type mountInfo struct {
+	root       string
+	mountPoint string
+	fsType     string
+	source     string
+	superOpts  []string
+}
+
+func readMountInfo(path string) ([]mountInfo, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	mounts := make([]mountInfo, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// id parent major:minor root mount-point options [optional fields] - fs-type source super-options
+		before, after, ok := strings.Cut(scanner.Text(), " - ")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(before)
+		tail := strings.Fields(after)
+		if len(fields) < 5 || len(tail) < 3 {
+			continue
+		}
+		mounts = append(mounts, mountInfo{
+			root:       unescapeMountPath(fields[3]),
+			mountPoint: unescapeMountPath(fields[4]),
+			fsType:     tail[0],
+			source:     tail[1],
+			superOpts:  strings.Split(tail[2], ","),
+		})
+	}
+	return mounts, scanner.Err()
+}
+
+// unescapeMountPath decodes the octal escapes mountinfo uses for spaces, tabs, newlines and backslashes
+func unescapeMountPath(path string) string {
+	if !strings.Contains(path, `\`) {
+		return path
+	}
+	var builder strings.Builder
+	for i := 0; i < len(path); i++ {
+		if path[i] == '\\' && i+3 < len(path) {
+			if code, err := strconv.ParseUint(path[i+1:i+4], 8, 8); err == nil {
+				builder.WriteByte(byte(code))
+				i += 3
+				continue
+			}
+		}
+		builder.WriteByte(path[i])
+	}
+	return builder.String()
+}
+
+func cgroupV1Dir(mounts []mountInfo, paths map[string]string, controller string) (string, string, bool) {
+	cgroupPath, ok := paths[controller]
+	if !ok {
+		return "", "", false
+	}
+	for _, mount := range mounts {
+		if mount.fsType == "cgroup" && slices.Contains(mount.superOpts, controller) {
+			return cgroupDir(mount, cgroupPath), mount.mountPoint, true
+		}
+	}
+	return "", "", false
+}
+
+func cgroupV2Dir(mounts []mountInfo, unifiedPath string) (string, string, bool) {
+	if unifiedPath == "" {
+		return "", "", false
+	}
+	for _, mount := range mounts {
+		if mount.fsType == "cgroup2" {
+			return cgroupDir(mount, unifiedPath), mount.mountPoint, true
+		}
+	}
+	return "", "", false
+}
+
+// cgroupDir falls back to the mount point when the cgroup isn't visible under it,
+// as in containers whose cgroup hierarchy is mounted from their own cgroup
+func cgroupDir(mount mountInfo, cgroupPath string) string {
+	relative := cgroupPath
+	if mount.root != "/" {
+		relative = strings.TrimPrefix(cgroupPath, mount.root)
+	}
+	dir := filepath.Join(mount.mountPoint, relative)
+	if _, err := os.Stat(dir); err != nil {
+		return mount.mountPoint
+	}
+	return dir
+}
+
+// walkCgroup reads a limit from dir and each of its ancestors up to the mount point and
+// returns the lowest, read returns zero for a level without a limit
+func walkCgroup[T float64 | uint64](dir, mountPoint string, read func(dir string) T) T {
+	var limit T
+	for {
+		if levelLimit := read(dir); levelLimit > 0 && (limit == 0 || levelLimit < limit) {
+			limit = levelLimit
+		}
+		if dir == mountPoint || !strings.HasPrefix(dir, mountPoint) {
+			return limit
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func readCgroupV1CpuQuota(dir string) float64 {
+	quota, err := readSysFloat(filepath.Join(dir, "cpu.cfs_quota_us"))
+	if err != nil || quota <= 0 {
+		return 0
+	}
+	period, err := readSysFloat(filepath.Join(dir, "cpu.cfs_period_us"))
+	if err != nil || period <= 0 {
+		return 0
+	}
+	return quota / period
+}
+
+// readCgroupV2CpuQuota reads cpu.max, "$MAX $PERIOD" with a max of "max" when there's no limit
+func readCgroupV2CpuQuota(dir string) float64 {
+	data, err := os.ReadFile(filepath.Join(dir, "cpu.max"))
+	if err != nil {
+		return 0
+	}
+	fields := strings.Fields(string(data))
+	if len(fields) != 2 || fields[0] == "max" {
+		return 0
+	}
+	quota, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	period, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil || period <= 0 {
+		return 0
+	}
+	return quota / period
+}
+
+func readCgroupV1MemoryLimit(dir string) uint64 {
+	limit, err := readSysUint(filepath.Join(dir, "memory.limit_in_bytes"))
+	if err != nil || limit >= cgroupV1UnlimitedBytes {
+		return 0
+	}
+	return limit
+}
+
+func readCgroupV2MemoryLimit(dir string) uint64 {
+	limit, err := readSysUint(filepath.Join(dir, "memory.max"))
+	if err != nil {
+		return 0
+	}
+	return limit
+}
+
+// readCpusetCores counts the cpus of the first readable file, given as a list like 0-3,6
+func readCpusetCores(dir string, fileNames ...string) int {
+	for _, fileName := range fileNames {
+		data, err := os.ReadFile(filepath.Join(dir, fileName))
+		if err != nil {
+			continue
+		}
+		list := strings.TrimSpace(string(data))
+		if list == "" {
+			continue
+		}
+		cores := 0
+		for _, cpuRange := range strings.Split(list, ",") {
+			first, last, isRange := strings.Cut(cpuRange, "-")
+			if !isRange {
+				cores++
+				continue
+			}
+			from, err := strconv.Atoi(first)
+			if err != nil {
+				return 0
+			}
+			to, err := strconv.Atoi(last)
+			if err != nil || to < from {
+				return 0
+			}
+			cores += to - from + 1
+		}
+		return cores
+	}
+	return 0
+}
+
+func readSysUint(path string) (uint64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// dataVolume returns the mount holding path, the one with the longest mount point containing it
+func dataVolume(path string) (mountInfo, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return mountInfo{}, err
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	mounts, err := readMountInfo("/proc/self/mountinfo")
+	if err != nil {
+		return mountInfo{}, err
+	}
+	volume := mountInfo{}
+	for _, mount := range mounts {
+		if !pathWithin(path, mount.mountPoint) || len(mount.mountPoint) < len(volume.mountPoint) {
+			continue
+		}
+		volume = mount
+	}
+	if volume.mountPoint == "" {
+		return mountInfo{}, errors.New("no mount holds " + path)
+	}
+	return volume, nil
+}
+
+func pathWithin(path, dir string) bool {
+	return dir == "/" || path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
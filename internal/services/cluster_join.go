@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
 	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
 )
 
 type ClusterJoinListener struct {
@@ -14,14 +16,16 @@ type ClusterJoinListener struct {
 	serf        *SerfAgent
 	nodeId      string
 	nodeIdStore domain.NodeIdStore
+	signer      domain.NodeSigner
 }
 
-func NewClusterJoinListener(conn *nats.Conn, serf *SerfAgent, nodeId string, nodeIdStore domain.NodeIdStore) *ClusterJoinListener {
+func NewClusterJoinListener(conn *nats.Conn, serf *SerfAgent, nodeId string, nodeIdStore domain.NodeIdStore, signer domain.NodeSigner) *ClusterJoinListener {
 	return &ClusterJoinListener{
 		conn:        conn,
 		serf:        serf,
 		nodeId:      nodeId,
 		nodeIdStore: nodeIdStore,
+		signer:      signer,
 	}
 }
 
@@ -39,14 +43,40 @@ func (l *ClusterJoinListener) Listen() {
 		err := l.serf.Join(address)
 		if err != nil {
 			log.Println(err)
+			l.ack(msg, clusterId, err)
 			return
 		}
 		err = l.nodeIdStore.PutClusterId(clusterId)
 		if err != nil {
 			log.Println(err)
 		}
+		l.ack(msg, clusterId, err)
 	})
 	if err != nil {
 		log.Println(err)
 	}
 }
+
+// ack answers the join request with a signed JoinAck, if it was sent as a request
+func (l *ClusterJoinListener) ack(msg *nats.Msg, clusterId string, err error) {
+	if msg.Reply == "" {
+		return
+	}
+	ack := &api.JoinAck{
+		NodeId:    l.nodeId,
+		ClusterId: clusterId,
+		Success:   err == nil,
+	}
+	if err != nil {
+		ack.ErrorMessage = err.Error()
+	}
+	data, err := proto.Marshal(ack)
+	if err != nil {
+		log.Printf("Failed to marshal join ack: %v", err)
+		return
+	}
+	err = msg.RespondMsg(SignedMsg(l.signer, l.nodeId, msg.Reply, data))
+	if err != nil {
+		log.Printf("Failed to send join ack: %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
+	"github.com/nats-io/nats.go"
+	"google.golang.org/protobuf/proto"
+)
+
+// NodeHeartbeatSubject is where heartbeats are sent to magnetar, see NodeHeartbeatReq
+const NodeHeartbeatSubject = "magnetar.heartbeat"
+
+// HeartbeatService keeps the labels and resources magnetar has for the node up to date.
+// When magnetar no longer knows the node id, the node is registered again and
+// onReregistered is called with the new id, heartbeats carry the new id from then on.
+// A stopped heartbeat can be restarted, e.g. when a decommission fails.
+type HeartbeatService struct {
+	conn           *nats.Conn
+	registration   *RegistrationService
+	signer         domain.NodeSigner
+	nodeIdStore    domain.NodeIdStore
+	index          *AppIndex
+	bindAddress    string
+	maxRetries     int8
+	interval       time.Duration
+	timeout        time.Duration
+	onReregistered func(nodeId string)
+	triggerChannel chan struct{}
+	lock           sync.Mutex
+	stopped        bool
+	stopChannel    chan struct{}
+	// ctx cancels a registration in progress on Stop
+	ctx    context.Context
+	cancel context.CancelFunc
+	Wg     sync.WaitGroup
+}
+
+func NewHeartbeatService(conn *nats.Conn, registration *RegistrationService, signer domain.NodeSigner, nodeIdStore domain.NodeIdStore, index *AppIndex, bindAddress string, maxRetries int8, interval, timeout time.Duration, onReregistered func(nodeId string)) *HeartbeatService {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &HeartbeatService{
+		conn:           conn,
+		registration:   registration,
+		signer:         signer,
+		nodeIdStore:    nodeIdStore,
+		index:          index,
+		bindAddress:    bindAddress,
+		maxRetries:     maxRetries,
+		interval:       interval,
+		timeout:        timeout,
+		onReregistered: onReregistered,
+		triggerChannel: make(chan struct{}, 1),
+		stopChannel:    make(chan struct{}),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+}
+
+func (h *HeartbeatService) Run() {
+	defer h.Wg.Done()
+	// only replaced by Restart once the previous run returned, before this one is started
+	stopChannel, ctx := h.stopChannel, h.ctx
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			h.beat(ctx)
+		case <-h.triggerChannel:
+			h.beat(ctx)
+		case <-stopChannel:
+			log.Println("heartbeat stopped")
+			return
+		}
+	}
+}
+
+// Trigger sends a heartbeat right away, e.g. when the node labels change
+func (h *HeartbeatService) Trigger() {
+	select {
+	case h.triggerChannel <- struct{}{}:
+	default:
+	}
+}
+
+// Stop can be called more than once, the heartbeat is stopped first when the node is decommissioned
+func (h *HeartbeatService) Stop() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.stopped {
+		return
+	}
+	h.stopped = true
+	close(h.stopChannel)
+	h.cancel()
+	h.Wg.Wait()
+}
+
+// Restart runs a stopped heartbeat again and sends a heartbeat right away
+func (h *HeartbeatService) Restart() {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if !h.stopped {
+		return
+	}
+	h.stopped = false
+	h.stopChannel = make(chan struct{})
+	h.ctx, h.cancel = context.WithCancel(context.Background())
+	h.Wg.Add(1)
+	go h.Run()
+	h.Trigger()
+}
+
+func (h *HeartbeatService) beat(ctx context.Context) {
+	nodeId, err := h.nodeIdStore.Get()
+	if err != nil {
+		log.Printf("Failed to read node id for heartbeat: %v", err)
+		return
+	}
+	registration := h.registration.buildReq(h.bindAddress)
+	if memoryFreeGB, err := memoryFreeGB(); err == nil {
+		registration.Resources["mem-free"] = memoryFreeGB
+	}
+	if diskFreeGB, err := h.registration.resources.DiskFreeGB(ctx); err == nil {
+		registration.Resources["disk-free"] = diskFreeGB
+	}
+	registration.Resources["apps-running"] = float64(h.index.RunningCount())
+	registrationMarshalled, err := registration.Marshal()
+	if err != nil {
+		log.Printf("Failed to marshal heartbeat: %v", err)
+		return
+	}
+	data, err := proto.Marshal(&api.NodeHeartbeatReq{
+		NodeId:       nodeId.Value,
+		Registration: registrationMarshalled,
+		Timestamp:    time.Now().UnixNano(),
+	})
+	if err != nil {
+		log.Printf("Failed to marshal heartbeat: %v", err)
+		return
+	}
+
+	msg, err := h.conn.RequestMsg(SignedMsg(h.signer, nodeId.Value, NodeHeartbeatSubject, data), h.timeout)
+	if err != nil {
+		log.Printf("Heartbeat to magnetar failed: %v", err)
+		return
+	}
+	resp := &api.NodeHeartbeatResp{}
+	err = proto.Unmarshal(msg.Data, resp)
+	if err != nil {
+		log.Printf("Failed to unmarshal heartbeat response: %v", err)
+		return
+	}
+	if resp.Known {
+		return
+	}
+
+	log.Printf("Magnetar does not know node %s, registering again", nodeId.Value)
+	err = h.registration.Register(ctx, h.maxRetries, h.bindAddress)
+	if err != nil {
+		log.Printf("Failed to register node again: %v", err)
+		return
+	}
+	newNodeId, err := h.nodeIdStore.Get()
+	if err != nil {
+		log.Printf("Failed to read new node id: %v", err)
+		return
+	}
+	log.Printf("Node registered again as %s", newNodeId.Value)
+	h.onReregistered(newNodeId.Value)
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+)
+
+const dockerHubAuthKey = "https://index.docker.io/v1/"
+
+type pullCall struct {
+	done chan struct{}
+	err  error
+}
+
+// ImagePuller makes sure app images are present before containers are created,
+// concurrent pulls of the same image share a single request to the docker daemon.
+// A pull is given up after the pull timeout, so a hung registry doesn't block later pulls.
+type ImagePuller struct {
+	dockerClient *client.Client
+	credentials  map[string]registry.AuthConfig
+	timeout      time.Duration
+	pulls        map[string]*pullCall
+	lock         sync.Mutex
+}
+
+func NewImagePuller(dockerClient *client.Client, authFilePath, server, username, password string, timeout time.Duration) (*ImagePuller, error) {
+	credentials := make(map[string]registry.AuthConfig)
+	if authFilePath != "" {
+		fileCredentials, err := readRegistryAuthFile(authFilePath)
+		if err != nil {
+			return nil, err
+		}
+		credentials = fileCredentials
+	}
+	if username != "" {
+		credentials[normalizeRegistryHost(server)] = registry.AuthConfig{
+			Username:      username,
+			Password:      password,
+			ServerAddress: server,
+		}
+	}
+	return &ImagePuller{
+		dockerClient: dockerClient,
+		credentials:  credentials,
+		timeout:      timeout,
+		pulls:        make(map[string]*pullCall),
+	}, nil
+}
+
+func (p *ImagePuller) EnsureImage(ctx context.Context, imageRef string, policy domain.ImagePullPolicy) error {
+	if policy != domain.ImagePullAlways {
+		_, _, err := p.dockerClient.ImageInspectWithRaw(ctx, imageRef)
+		if err == nil {
+			return nil
+		}
+		if !errdefs.IsNotFound(err) {
+			return err
+		}
+		if policy == domain.ImagePullNever {
+			return fmt.Errorf("image %s is not present and the pull policy is %s", imageRef, policy)
+		}
+	}
+	return p.pull(ctx, imageRef)
+}
+
+func (p *ImagePuller) pull(ctx context.Context, imageRef string) error {
+	p.lock.Lock()
+	call, ok := p.pulls[imageRef]
+	if !ok {
+		call = &pullCall{done: make(chan struct{})}
+		p.pulls[imageRef] = call
+		// the pull is not tied to the context of the first caller, others may still wait for it
+		go p.doPull(imageRef, call)
+	}
+	p.lock.Unlock()
+
+	select {
+	case <-call.done:
+		return call.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *ImagePuller) doPull(imageRef string, call *pullCall) {
+	defer func() {
+		p.lock.Lock()
+		delete(p.pulls, imageRef)
+		p.lock.Unlock()
+		close(call.done)
+	}()
+
+	log.Printf("Pulling image %s", imageRef)
+	options := image.PullOptions{}
+	auth, ok, err := p.registryAuth(imageRef)
+	if err != nil {
+		call.err = err
+		return
+	}
+	if ok {
+		options.RegistryAuth = auth
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	reader, err := p.dockerClient.ImagePull(ctx, imageRef, options)
+	if err == nil {
+		err = readPullProgress(reader)
+		reader.Close()
+	}
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("pull not finished within %s: %w", p.timeout, err)
+	}
+	call.err = err
+	if call.err == nil {
+		log.Printf("Image %s pulled", imageRef)
+	}
+}
+
+func (p *ImagePuller) registryAuth(imageRef string) (string, bool, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return "", false, err
+	}
+	authConfig, ok := p.credentials[normalizeRegistryHost(reference.Domain(named))]
+	if !ok {
+		return "", false, nil
+	}
+	auth, err := registry.EncodeAuthConfig(authConfig)
+	return auth, err == nil, err
+}
+
+// readPullProgress drains the progress stream, pull errors are only reported inside of it
+func readPullProgress(reader io.Reader) error {
+	decoder := json.NewDecoder(reader)
+	for {
+		message := struct {
+			Error       string `json:"error"`
+			ErrorDetail struct {
+				Message string `json:"message"`
+			} `json:"errorDetail"`
+		}{}
+		err := decoder.Decode(&message)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if message.ErrorDetail.Message != "" {
+			return errors.New(message.ErrorDetail.Message)
+		}
+		if message.Error != "" {
+			return errors.New(message.Error)
+		}
+	}
+}
+
+// readRegistryAuthFile reads credentials from a docker config.json style file
+func readRegistryAuthFile(path string) (map[string]registry.AuthConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file := struct {
+		Auths map[string]registry.AuthConfig `json:"auths"`
+	}{}
+	err = json.Unmarshal(data, &file)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry auth file %s: %w", path, err)
+	}
+	credentials := make(map[string]registry.AuthConfig)
+	for server, authConfig := range file.Auths {
+		if authConfig.Auth != "" && authConfig.Username == "" {
+			decoded, err := base64.StdEncoding.DecodeString(authConfig.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("invalid auth for registry %s: %w", server, err)
+			}
+			authConfig.Username, authConfig.Password, _ = strings.Cut(string(decoded), ":")
+			authConfig.Auth = ""
+		}
+		authConfig.ServerAddress = server
+		credentials[normalizeRegistryHost(server)] = authConfig
+	}
+	return credentials, nil
+}
+
+func normalizeRegistryHost(server string) string {
+	if server == "" || server == dockerHubAuthKey || server == "index.docker.io" || server == "registry-1.docker.io" {
+		return "docker.io"
+	}
+	host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+	host, _, _ = strings.Cut(host, "/")
+	return host
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	magnetarapi "github.com/c12s/magnetar/pkg/api"
+)
+
+// CacheForever keeps the labels of a provider for as long as star runs, for facts that
+// only change with a restart of the node, like the cpu topology
+const CacheForever = time.Duration(math.MaxInt64)
+
+// LabelProvider reports a group of node facts as labels. Facts that can't be probed are
+// left out, an error is returned only when the provider has nothing to report.
+type LabelProvider interface {
+	Name() string
+	Labels(ctx context.Context) ([]NodeLabel, error)
+}
+
+// NodeLabel holds a string, float64 or bool value, the types magnetar labels can have
+type NodeLabel struct {
+	key   string
+	value any
+}
+
+func StringLabel(key, value string) NodeLabel {
+	return NodeLabel{key: key, value: value}
+}
+
+func Float64Label(key string, value float64) NodeLabel {
+	return NodeLabel{key: key, value: value}
+}
+
+func BoolLabel(key string, value bool) NodeLabel {
+	return NodeLabel{key: key, value: value}
+}
+
+func (l NodeLabel) Key() string {
+	return l.key
+}
+
+func (l NodeLabel) Value() any {
+	return l.value
+}
+
+func (l NodeLabel) addTo(builder magnetarapi.RegistrationReqBuilder) magnetarapi.RegistrationReqBuilder {
+	switch value := l.value.(type) {
+	case string:
+		return builder.AddStringLabel(l.key, value)
+	case float64:
+		return builder.AddFloat64Label(l.key, value)
+	case bool:
+		return builder.AddBoolLabel(l.key, value)
+	}
+	return builder
+}
+
+type LabelProviderOptions struct {
+	// Timeout bounds a probe, the registry default is used when it is zero
+	Timeout time.Duration
+	// CacheFor is how long the labels are reused before probing again, zero probes every time
+	CacheFor time.Duration
+}
+
+// LabelProviderRegistry collects the labels of the registered providers. Providers are probed
+// concurrently, each within its own timeout. A provider that fails or times out contributes
+// the labels of its last successful probe, and a probe that timed out isn't started again
+// until it returns. When providers report the same key, the one registered first wins.
+type LabelProviderRegistry struct {
+	timeout   time.Duration
+	providers []*registeredLabelProvider
+	lock      sync.RWMutex
+	now       func() time.Time
+}
+
+func NewLabelProviderRegistry(timeout time.Duration) *LabelProviderRegistry {
+	return &LabelProviderRegistry{
+		timeout:   timeout,
+		providers: make([]*registeredLabelProvider, 0),
+		now:       time.Now,
+	}
+}
+
+func (r *LabelProviderRegistry) Register(provider LabelProvider, options LabelProviderOptions) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	for _, registered := range r.providers {
+		if registered.provider.Name() == provider.Name() {
+			return fmt.Errorf("label provider %s is already registered", provider.Name())
+		}
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = r.timeout
+	}
+	r.providers = append(r.providers, &registeredLabelProvider{
+		provider: provider,
+		options:  options,
+		now:      r.now,
+	})
+	return nil
+}
+
+// Collect returns the labels of all providers, in the order the providers were registered
+func (r *LabelProviderRegistry) Collect(ctx context.Context) []NodeLabel {
+	r.lock.RLock()
+	providers := make([]*registeredLabelProvider, len(r.providers))
+	copy(providers, r.providers)
+	r.lock.RUnlock()
+
+	results := make([][]NodeLabel, len(providers))
+	var wg sync.WaitGroup
+	for i, provider := range providers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			labels, err := provider.collect(ctx)
+			if err != nil {
+				log.Printf("Label provider %s failed: %v", provider.provider.Name(), err)
+			}
+			results[i] = labels
+		}()
+	}
+	wg.Wait()
+
+	labels := make([]NodeLabel, 0)
+	reportedBy := make(map[string]string)
+	for i, result := range results {
+		name := providers[i].provider.Name()
+		for _, label := range result {
+			if first, ok := reportedBy[label.key]; ok {
+				log.Printf("Label %s of provider %s is already reported by %s, ignoring it", label.key, name, first)
+				continue
+			}
+			reportedBy[label.key] = name
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+type registeredLabelProvider struct {
+	provider LabelProvider
+	options  LabelProviderOptions
+	now      func() time.Time
+	lock     sync.Mutex
+	labels   []NodeLabel
+	probed   bool
+	probedAt time.Time
+	err      error
+	// probing is closed once the probe in progress returns, it is nil when none is
+	probing chan struct{}
+}
+
+func (p *registeredLabelProvider) collect(ctx context.Context) ([]NodeLabel, error) {
+	p.lock.Lock()
+	if p.probed && p.now().Sub(p.probedAt) < p.options.CacheFor {
+		labels := p.labels
+		p.lock.Unlock()
+		return labels, nil
+	}
+	if p.probing == nil {
+		p.probing = make(chan struct{})
+		go p.probe(p.probing)
+	}
+	probing := p.probing
+	p.lock.Unlock()
+
+	timer := time.NewTimer(p.options.Timeout)
+	defer timer.Stop()
+	var err error
+	select {
+	case <-probing:
+	case <-timer.C:
+		err = fmt.Errorf("no labels within %s", p.options.Timeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err == nil {
+		err = p.err
+	}
+	return p.labels, err
+}
+
+// probe runs detached from the collecting context, so a probe that outlives one collection
+// still refreshes the labels for the next
+func (p *registeredLabelProvider) probe(probing chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.options.Timeout)
+	defer cancel()
+	labels, err := p.provider.Labels(ctx)
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.err = err
+	if err == nil {
+		p.labels = labels
+		p.probed = true
+		p.probedAt = p.now()
+	}
+	p.probing = nil
+	close(probing)
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+type fakeLabelProvider struct {
+	name    string
+	labels  []NodeLabel
+	err     error
+	release chan struct{}
+	calls   atomic.Int32
+	lock    sync.Mutex
+}
+
+func (p *fakeLabelProvider) Name() string {
+	return p.name
+}
+
+func (p *fakeLabelProvider) Labels(context.Context) ([]NodeLabel, error) {
+	p.calls.Add(1)
+	if p.release != nil {
+		<-p.release
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.labels, p.err
+}
+
+func (p *fakeLabelProvider) set(labels []NodeLabel, err error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.labels = labels
+	p.err = err
+}
+
+type fakeClock struct {
+	now  time.Time
+	lock sync.Mutex
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestLabelProviderRegistry(timeout time.Duration) (*LabelProviderRegistry, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	registry := NewLabelProviderRegistry(timeout)
+	registry.now = clock.Now
+	return registry, clock
+}
+
+func mustRegister(t *testing.T, registry *LabelProviderRegistry, provider LabelProvider, options LabelProviderOptions) {
+	t.Helper()
+	if err := registry.Register(provider, options); err != nil {
+		t.Fatalf("registering %s: %v", provider.Name(), err)
+	}
+}
+
+func labelKeys(labels []NodeLabel) []string {
+	keys := make([]string, 0, len(labels))
+	for _, label := range labels {
+		keys = append(keys, label.Key())
+	}
+	return keys
+}
+
+func TestLabelProviderRegistryCollectsInRegistrationOrder(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(time.Second)
+	mustRegister(t, registry, &fakeLabelProvider{name: "b", labels: []NodeLabel{StringLabel("b1", "x"), Float64Label("b2", 2)}}, LabelProviderOptions{})
+	mustRegister(t, registry, &fakeLabelProvider{name: "a", labels: []NodeLabel{BoolLabel("a1", true)}}, LabelProviderOptions{})
+
+	labels := registry.Collect(context.Background())
+
+	if got, want := labelKeys(labels), []string{"b1", "b2", "a1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+}
+
+func TestLabelProviderRegistryFirstProviderWinsOnSameKey(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(time.Second)
+	mustRegister(t, registry, &fakeLabelProvider{name: "detected", labels: []NodeLabel{StringLabel("hostname", "node-1")}}, LabelProviderOptions{})
+	mustRegister(t, registry, &fakeLabelProvider{name: "operator", labels: []NodeLabel{StringLabel("hostname", "other"), StringLabel("zone", "a")}}, LabelProviderOptions{})
+
+	labels := registry.Collect(context.Background())
+
+	if len(labels) != 2 || labels[0].Value() != "node-1" || labels[1].Key() != "zone" {
+		t.Fatalf("labels = %v, want hostname=node-1 and zone=a", labels)
+	}
+}
+
+func TestLabelProviderRegistryRejectsDuplicateName(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(time.Second)
+	mustRegister(t, registry, &fakeLabelProvider{name: "cpu"}, LabelProviderOptions{})
+
+	if err := registry.Register(&fakeLabelProvider{name: "cpu"}, LabelProviderOptions{}); err == nil {
+		t.Fatal("expected an error registering a provider name twice")
+	}
+}
+
+func TestLabelProviderRegistryCachesLabels(t *testing.T) {
+	registry, clock := newTestLabelProviderRegistry(time.Second)
+	cached := &fakeLabelProvider{name: "cached", labels: []NodeLabel{StringLabel("model", "a")}}
+	uncached := &fakeLabelProvider{name: "uncached", labels: []NodeLabel{Float64Label("free", 1)}}
+	mustRegister(t, registry, cached, LabelProviderOptions{CacheFor: time.Minute})
+	mustRegister(t, registry, uncached, LabelProviderOptions{})
+
+	registry.Collect(context.Background())
+	cached.set([]NodeLabel{StringLabel("model", "b")}, nil)
+	clock.advance(30 * time.Second)
+	labels := registry.Collect(context.Background())
+
+	if calls := cached.calls.Load(); calls != 1 {
+		t.Fatalf("cached provider probed %d times, want 1", calls)
+	}
+	if calls := uncached.calls.Load(); calls != 2 {
+		t.Fatalf("uncached provider probed %d times, want 2", calls)
+	}
+	if labels[0].Value() != "a" {
+		t.Fatalf("model = %v, want the cached a", labels[0].Value())
+	}
+
+	clock.advance(time.Minute)
+	labels = registry.Collect(context.Background())
+
+	if calls := cached.calls.Load(); calls != 2 {
+		t.Fatalf("cached provider probed %d times after expiry, want 2", calls)
+	}
+	if labels[0].Value() != "b" {
+		t.Fatalf("model = %v, want b after expiry", labels[0].Value())
+	}
+}
+
+func TestLabelProviderRegistryKeepsLabelsOfFailedProvider(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(time.Second)
+	provider := &fakeLabelProvider{name: "flaky", labels: []NodeLabel{StringLabel("version", "1")}}
+	mustRegister(t, registry, provider, LabelProviderOptions{})
+
+	registry.Collect(context.Background())
+	provider.set(nil, errors.New("probe failed"))
+	labels := registry.Collect(context.Background())
+
+	if len(labels) != 1 || labels[0].Value() != "1" {
+		t.Fatalf("labels = %v, want the last probed version=1", labels)
+	}
+}
+
+func TestLabelProviderRegistryTimesOutSlowProvider(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(50 * time.Millisecond)
+	slow := &fakeLabelProvider{name: "slow", labels: []NodeLabel{StringLabel("slow", "x")}, release: make(chan struct{})}
+	fast := &fakeLabelProvider{name: "fast", labels: []NodeLabel{StringLabel("fast", "y")}}
+	mustRegister(t, registry, slow, LabelProviderOptions{})
+	mustRegister(t, registry, fast, LabelProviderOptions{})
+
+	start := time.Now()
+	labels := registry.Collect(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("collect took %s, want it bounded by the provider timeout", elapsed)
+	}
+	if got, want := labelKeys(labels), []string{"fast"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("keys = %v, want %v", got, want)
+	}
+
+	// the probe that timed out still runs, it isn't started a second time
+	registry.Collect(context.Background())
+	if calls := slow.calls.Load(); calls != 1 {
+		t.Fatalf("slow provider probed %d times, want 1", calls)
+	}
+
+	close(slow.release)
+	deadline := time.Now().Add(time.Second)
+	for {
+		labels = registry.Collect(context.Background())
+		if len(labels) == 2 || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got, want := labelKeys(labels), []string{"slow", "fast"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("keys = %v, want %v once the slow probe returned", got, want)
+	}
+}
+
+func TestLabelProviderRegistryUsesProviderTimeout(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(time.Hour)
+	slow := &fakeLabelProvider{name: "slow", release: make(chan struct{})}
+	defer close(slow.release)
+	mustRegister(t, registry, slow, LabelProviderOptions{Timeout: 20 * time.Millisecond})
+
+	done := make(chan struct{})
+	go func() {
+		registry.Collect(context.Background())
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("collect didn't return within the provider timeout")
+	}
+}
+
+func TestRegistrationRequestReportsAllocatableResources(t *testing.T) {
+	registry, _ := newTestLabelProviderRegistry(time.Second)
+	mustRegister(t, registry, &fakeLabelProvider{name: "fake", labels: []NodeLabel{
+		Float64Label("cpu-capacity", 8),
+		Float64Label("memory-capacityGB", 16),
+		StringLabel("kernel-arch", "x86_64"),
+		BoolLabel("block-sda-rotational", false),
+	}}, LabelProviderOptions{})
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 8, MemoryGB: 16, DiskGB: 100},
+		domain.Resources{CPU: 1},
+		reservingApp("web", "running", `{"cpu":2,"memoryGB":4}`),
+	)
+	rs := NewRegistrationService(nil, nil, registry, tracker, time.Second)
+
+	req := rs.buildReq("10.0.0.1:7946")
+
+	for name, want := range map[string]float64{"cpu": 5, "mem": 12, "disk": 100, "cpu-capacity": 8, "cpu-reserved": 2} {
+		if req.Resources[name] != want {
+			t.Fatalf("resource %s = %v, want %v", name, req.Resources[name], want)
+		}
+	}
+	if req.BindAddress != "10.0.0.1:7946" {
+		t.Fatalf("bind address = %s", req.BindAddress)
+	}
+	if len(req.Labels) != 4 {
+		t.Fatalf("labels = %v, want 4", req.Labels)
+	}
+}
@@ -0,0 +1,377 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/host"
+	"github.com/shirou/gopsutil/mem"
+)
+
+// runtimeLabelsCacheFor spares the container runtime a version request on every heartbeat
+const runtimeLabelsCacheFor = 5 * time.Minute
+
+// RegisterNodeLabelProviders registers the providers of the labels star reports at registration
+// and with heartbeats. The operator labels come last, so they can't replace detected ones.
+func RegisterNodeLabelProviders(registry *LabelProviderRegistry, runtime domain.AppRuntime, signer domain.NodeSigner, operatorLabels *NodeLabels, dataVolumePath string) error {
+	providers := []struct {
+		provider LabelProvider
+		options  LabelProviderOptions
+	}{
+		{provider: cpuLabelProvider{}, options: LabelProviderOptions{CacheFor: CacheForever}},
+		{provider: diskLabelProvider{}},
+		{provider: hostLabelProvider{}, options: LabelProviderOptions{CacheFor: CacheForever}},
+		{provider: memoryLabelProvider{}},
+		{provider: networkLabelProvider{}},
+		{provider: storageLabelProvider{}},
+		{provider: capacityLabelProvider{dataVolumePath: dataVolumePath}},
+		{provider: runtimeLabelProvider{runtime: runtime}, options: LabelProviderOptions{CacheFor: runtimeLabelsCacheFor}},
+		{provider: identityLabelProvider{signer: signer}, options: LabelProviderOptions{CacheFor: CacheForever}},
+		{provider: operatorLabelProvider{labels: operatorLabels}},
+	}
+	for _, p := range providers {
+		err := registry.Register(p.provider, p.options)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cpuLabelProvider reports cpu-cores, cpu-physical-cores, cpu-sockets, numa-nodes and
+// core<n>mhz, core<n>vendorId, core<n>model and core<n>cacheKB for each core
+type cpuLabelProvider struct{}
+
+func (cpuLabelProvider) Name() string {
+	return "cpu"
+}
+
+func (cpuLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	labels := make([]NodeLabel, 0)
+	cpuCores, err := cpu.CountsWithContext(ctx, true)
+	if err == nil {
+		labels = append(labels, Float64Label("cpu-cores", float64(cpuCores)))
+	}
+	// cpu.Info is read once, it parses /proc/cpuinfo on every call
+	cores, infoErr := cpu.InfoWithContext(ctx)
+	if infoErr == nil {
+		coresById := make(map[string]cpu.InfoStat, len(cores))
+		sockets := make(map[string]struct{})
+		for _, core := range cores {
+			if _, ok := coresById[core.CoreID]; !ok {
+				coresById[core.CoreID] = core
+			}
+			sockets[core.PhysicalID] = struct{}{}
+		}
+		for coreId := 0; coreId < cpuCores; coreId++ {
+			core, ok := coresById[strconv.Itoa(coreId)]
+			if !ok {
+				continue
+			}
+			labels = append(labels,
+				Float64Label(fmt.Sprintf("core%dmhz", coreId), core.Mhz),
+				StringLabel(fmt.Sprintf("core%dvendorId", coreId), core.VendorID),
+				StringLabel(fmt.Sprintf("core%dmodel", coreId), core.ModelName),
+				Float64Label(fmt.Sprintf("core%dcacheKB", coreId), float64(core.CacheSize/1000)))
+		}
+		labels = append(labels, Float64Label("cpu-sockets", float64(len(sockets))))
+	}
+	cpuPhysicalCores, err := cpu.CountsWithContext(ctx, false)
+	if err == nil {
+		labels = append(labels, Float64Label("cpu-physical-cores", float64(cpuPhysicalCores)))
+	}
+	numaNodes, err := numaNodes()
+	if err == nil {
+		labels = append(labels, Float64Label("numa-nodes", numaNodes))
+	}
+	return nonEmptyLabels(labels, infoErr)
+}
+
+// diskLabelProvider reports fs-type, disk-totalGB and disk-freeGB of the root filesystem
+type diskLabelProvider struct{}
+
+func (diskLabelProvider) Name() string {
+	return "disk"
+}
+
+func (diskLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	diskInfo, err := disk.UsageWithContext(ctx, "/")
+	if err != nil {
+		return nil, err
+	}
+	labels := make([]NodeLabel, 0, 3)
+	if diskInfo.Fstype != "" {
+		labels = append(labels, StringLabel("fs-type", diskInfo.Fstype))
+	}
+	return append(labels,
+		Float64Label("disk-totalGB", float64(diskInfo.Total/1000000000)),
+		Float64Label("disk-freeGB", float64(diskInfo.Free/1000000000))), nil
+}
+
+// hostLabelProvider reports kernel-arch, kernel-version, platform, platform-family,
+// platform-version, hostname and boot-time
+type hostLabelProvider struct{}
+
+func (hostLabelProvider) Name() string {
+	return "host"
+}
+
+func (hostLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	labels := make([]NodeLabel, 0)
+	kernelArch, err := host.KernelArch()
+	if err == nil {
+		labels = append(labels, StringLabel("kernel-arch", kernelArch))
+	}
+	kernelVersion, err := host.KernelVersionWithContext(ctx)
+	if err == nil {
+		labels = append(labels, StringLabel("kernel-version", kernelVersion))
+	}
+	platform, platformFamily, platformVersion, err := host.PlatformInformationWithContext(ctx)
+	if err == nil {
+		labels = append(labels,
+			StringLabel("platform", platform),
+			StringLabel("platform-family", platformFamily),
+			StringLabel("platform-version", platformVersion))
+	}
+	hostname, err := os.Hostname()
+	if err == nil {
+		labels = append(labels, StringLabel("hostname", hostname))
+	}
+	bootTime, err := host.BootTimeWithContext(ctx)
+	if err == nil {
+		labels = append(labels, Float64Label("boot-time", float64(bootTime)))
+	}
+	return nonEmptyLabels(labels, err)
+}
+
+// memoryLabelProvider reports memory-totalGB, swap-totalGB and swap-freeGB
+type memoryLabelProvider struct{}
+
+func (memoryLabelProvider) Name() string {
+	return "memory"
+}
+
+func (memoryLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	labels := make([]NodeLabel, 0, 3)
+	memInfo, err := mem.VirtualMemoryWithContext(ctx)
+	if err == nil {
+		labels = append(labels, Float64Label("memory-totalGB", float64(memInfo.Total/1000000000)))
+	}
+	swapInfo, err := mem.SwapMemoryWithContext(ctx)
+	if err == nil {
+		labels = append(labels,
+			Float64Label("swap-totalGB", float64(swapInfo.Total/1000000000)),
+			Float64Label("swap-freeGB", float64(swapInfo.Free/1000000000)))
+	}
+	return nonEmptyLabels(labels, err)
+}
+
+// networkLabelProvider reports net-interfaces with the names of the interfaces,
+// and net-<name>-mac, net-<name>-ips and net-<name>-speedMbps for each of them
+type networkLabelProvider struct{}
+
+func (networkLabelProvider) Name() string {
+	return "network"
+}
+
+func (networkLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	interfaces, err := networkInterfaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labels := make([]NodeLabel, 0)
+	names := make([]string, 0, len(interfaces))
+	for _, iface := range interfaces {
+		names = append(names, iface.name)
+		if iface.mac != "" {
+			labels = append(labels, StringLabel(fmt.Sprintf("net-%s-mac", iface.name), iface.mac))
+		}
+		if len(iface.ips) > 0 {
+			labels = append(labels, StringLabel(fmt.Sprintf("net-%s-ips", iface.name), strings.Join(iface.ips, ",")))
+		}
+		if iface.speedMbps > 0 {
+			labels = append(labels, Float64Label(fmt.Sprintf("net-%s-speedMbps", iface.name), iface.speedMbps))
+		}
+	}
+	return append(labels, StringLabel("net-interfaces", strings.Join(names, ","))), nil
+}
+
+// storageLabelProvider reports mount<n>path, mount<n>device, mount<n>fsType, mount<n>totalGB
+// and mount<n>freeGB for each mounted filesystem, and block-<name>-sizeGB and
+// block-<name>-rotational for each block device, whose names are in block-devices
+type storageLabelProvider struct{}
+
+func (storageLabelProvider) Name() string {
+	return "storage"
+}
+
+func (storageLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	labels := make([]NodeLabel, 0)
+	filesystems, err := mountedFilesystems(ctx)
+	if err == nil {
+		labels = append(labels, Float64Label("mount-count", float64(len(filesystems))))
+		for i, filesystem := range filesystems {
+			labels = append(labels,
+				StringLabel(fmt.Sprintf("mount%dpath", i), filesystem.path),
+				StringLabel(fmt.Sprintf("mount%ddevice", i), filesystem.device),
+				StringLabel(fmt.Sprintf("mount%dfsType", i), filesystem.fsType),
+				Float64Label(fmt.Sprintf("mount%dtotalGB", i), filesystem.totalGB),
+				Float64Label(fmt.Sprintf("mount%dfreeGB", i), filesystem.freeGB))
+		}
+	}
+	devices, err := blockDevices()
+	if err == nil {
+		names := make([]string, 0, len(devices))
+		for _, device := range devices {
+			names = append(names, device.name)
+			labels = append(labels,
+				Float64Label(fmt.Sprintf("block-%s-sizeGB", device.name), device.sizeGB),
+				BoolLabel(fmt.Sprintf("block-%s-rotational", device.name), device.rotational))
+		}
+		labels = append(labels, StringLabel("block-devices", strings.Join(names, ",")))
+	}
+	return nonEmptyLabels(labels, err)
+}
+
+// capacityLabelProvider reports the host capacity bounded by the cgroup star runs in as
+// cpu-capacity, memory-capacityGB and disk-capacityGB, the size of the data volume.
+// The limits are reported as cgroup-version, cgroup-cpu-quota, cgroup-cpuset-cores and
+// cgroup-memory-limitGB, the volume holding the data path as data-volume-path,
+// data-volume-device, data-volume-totalGB and data-volume-freeGB.
+type capacityLabelProvider struct {
+	dataVolumePath string
+}
+
+func (capacityLabelProvider) Name() string {
+	return "capacity"
+}
+
+func (p capacityLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	labels := make([]NodeLabel, 0)
+	limits, err := readCgroupLimits()
+	if err != nil {
+		log.Printf("Failed to read the cgroup limits: %v", err)
+	}
+	if limits.version > 0 {
+		labels = append(labels, Float64Label("cgroup-version", float64(limits.version)))
+	}
+	if limits.cpuQuota > 0 {
+		labels = append(labels, Float64Label("cgroup-cpu-quota", limits.cpuQuota))
+	}
+	if limits.cpusetCores > 0 {
+		labels = append(labels, Float64Label("cgroup-cpuset-cores", float64(limits.cpusetCores)))
+	}
+	if limits.memoryLimit > 0 {
+		labels = append(labels, Float64Label("cgroup-memory-limitGB", gigabytes(limits.memoryLimit)))
+	}
+
+	diskPath := dataDiskPath(p.dataVolumePath)
+	if diskPath != "/" {
+		volume, err := dataVolume(diskPath)
+		if err == nil {
+			labels = append(labels,
+				StringLabel("data-volume-path", volume.mountPoint),
+				StringLabel("data-volume-device", volume.source))
+		}
+		usage, err := disk.UsageWithContext(ctx, diskPath)
+		if err == nil {
+			labels = append(labels,
+				Float64Label("data-volume-totalGB", gigabytes(usage.Total)),
+				Float64Label("data-volume-freeGB", gigabytes(usage.Free)))
+		}
+	}
+
+	capacity, err := capacityWithin(ctx, limits, diskPath)
+	if err == nil {
+		labels = append(labels,
+			Float64Label("cpu-capacity", capacity.CPU),
+			Float64Label("memory-capacityGB", capacity.MemoryGB),
+			Float64Label("disk-capacityGB", capacity.DiskGB))
+	}
+	return nonEmptyLabels(labels, err)
+}
+
+// runtimeLabelProvider reports container-runtime and container-runtime-version
+type runtimeLabelProvider struct {
+	runtime domain.AppRuntime
+}
+
+func (runtimeLabelProvider) Name() string {
+	return "runtime"
+}
+
+func (p runtimeLabelProvider) Labels(ctx context.Context) ([]NodeLabel, error) {
+	labels := []NodeLabel{StringLabel("container-runtime", p.runtime.Name())}
+	version, err := p.runtime.Version(ctx)
+	if err != nil {
+		log.Printf("Failed to get the container runtime version: %v", err)
+		return labels, nil
+	}
+	if version != "" {
+		labels = append(labels, StringLabel("container-runtime-version", version))
+	}
+	return labels, nil
+}
+
+// identityLabelProvider reports node-public-key, magnetar verifies the messages the node signs with it
+type identityLabelProvider struct {
+	signer domain.NodeSigner
+}
+
+func (identityLabelProvider) Name() string {
+	return "identity"
+}
+
+func (p identityLabelProvider) Labels(context.Context) ([]NodeLabel, error) {
+	return []NodeLabel{StringLabel("node-public-key", p.signer.PublicKey())}, nil
+}
+
+// operatorLabelProvider reports the labels given in the configuration
+type operatorLabelProvider struct {
+	labels *NodeLabels
+}
+
+func (operatorLabelProvider) Name() string {
+	return "operator"
+}
+
+func (p operatorLabelProvider) Labels(context.Context) ([]NodeLabel, error) {
+	operatorLabels := p.labels.Labels()
+	keys := make([]string, 0, len(operatorLabels))
+	for key := range operatorLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	labels := make([]NodeLabel, 0, len(keys))
+	for _, key := range keys {
+		labels = append(labels, StringLabel(key, operatorLabels[key]))
+	}
+	return labels, nil
+}
+
+// gigabytes keeps two decimals, limits of containers are often below a gigabyte
+func gigabytes(bytes uint64) float64 {
+	return math.Floor(float64(bytes)/10000000) / 100
+}
+
+// nonEmptyLabels fails a provider that probed nothing, with the last probe error
+func nonEmptyLabels(labels []NodeLabel, err error) ([]NodeLabel, error) {
+	if len(labels) > 0 {
+		return labels, nil
+	}
+	if err == nil {
+		err = errors.New("no facts could be probed")
+	}
+	return nil, err
+}
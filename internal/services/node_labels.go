@@ -0,0 +1,206 @@
+package services
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+// NodeLabels holds the labels the operator gives the node, merged from a comma separated
+// list of key=value pairs, a file with a key=value pair per line and a directory with a
+// file per label, named by the key and holding the value. Later sources override earlier
+// ones. The file and the directory are reloaded periodically and the listeners are called
+// whenever the labels change.
+type NodeLabels struct {
+	list        string
+	filePath    string
+	dirPath     string
+	interval    time.Duration
+	labels      map[string]string
+	listeners   []func(labels map[string]string)
+	lock        sync.RWMutex
+	stopChannel chan struct{}
+	Wg          sync.WaitGroup
+}
+
+func NewNodeLabels(list, filePath, dirPath string, interval time.Duration) (*NodeLabels, error) {
+	n := &NodeLabels{
+		list:        list,
+		filePath:    filePath,
+		dirPath:     dirPath,
+		interval:    interval,
+		listeners:   make([]func(labels map[string]string), 0),
+		stopChannel: make(chan struct{}),
+	}
+	labels, err := n.load()
+	if err != nil {
+		return nil, err
+	}
+	n.labels = labels
+	return n, nil
+}
+
+// AddListener registers a callback for label changes, it must be called before Watch
+func (n *NodeLabels) AddListener(listener func(labels map[string]string)) {
+	n.listeners = append(n.listeners, listener)
+}
+
+func (n *NodeLabels) Labels() map[string]string {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+	return maps.Clone(n.labels)
+}
+
+// Watch reloads the labels until Stop is called, invalid labels are logged and the previous ones kept
+func (n *NodeLabels) Watch() {
+	defer n.Wg.Done()
+	if n.filePath == "" && n.dirPath == "" {
+		return
+	}
+	ticker := time.NewTicker(n.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			n.reload()
+		case <-n.stopChannel:
+			log.Println("node labels watcher stopped")
+			return
+		}
+	}
+}
+
+func (n *NodeLabels) Stop() {
+	close(n.stopChannel)
+	n.Wg.Wait()
+}
+
+func (n *NodeLabels) reload() {
+	labels, err := n.load()
+	if err != nil {
+		log.Printf("Failed to reload node labels, keeping the previous ones: %v", err)
+		return
+	}
+	n.lock.Lock()
+	if maps.Equal(labels, n.labels) {
+		n.lock.Unlock()
+		return
+	}
+	n.labels = labels
+	n.lock.Unlock()
+	log.Printf("Node labels changed: %v", labels)
+	for _, listener := range n.listeners {
+		listener(maps.Clone(labels))
+	}
+}
+
+func (n *NodeLabels) load() (map[string]string, error) {
+	labels := make(map[string]string)
+	for _, pair := range strings.Split(n.list, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		err := putNodeLabelPair(labels, pair)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if n.filePath != "" {
+		err := readNodeLabelsFile(n.filePath, labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if n.dirPath != "" {
+		err := readNodeLabelsDir(n.dirPath, labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// the labels are gossiped as serf tags, which are limited in size
+	err := validateSerfLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+func putNodeLabelPair(labels map[string]string, pair string) error {
+	key, value, ok := strings.Cut(pair, "=")
+	if !ok {
+		return fmt.Errorf("invalid node label %q: expected key=value", pair)
+	}
+	key = strings.TrimSpace(key)
+	err := domain.ValidateNodeLabelKey(key)
+	if err != nil {
+		return err
+	}
+	labels[key] = strings.TrimSpace(value)
+	return nil
+}
+
+// readNodeLabelsFile skips empty lines and lines starting with #, a missing file has no labels
+func readNodeLabelsFile(path string, labels map[string]string) error {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		err := putNodeLabelPair(labels, line)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// readNodeLabelsDir skips hidden files, like the ..data links of mounted kubernetes volumes,
+// a missing directory has no labels
+func readNodeLabelsDir(path string, labels map[string]string) error {
+	entries, err := os.ReadDir(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		key := entry.Name()
+		if strings.HasPrefix(key, ".") {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(path, key))
+		if err != nil || info.IsDir() {
+			continue
+		}
+		err = domain.ValidateNodeLabelKey(key)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		value, err := os.ReadFile(filepath.Join(path, key))
+		if err != nil {
+			return err
+		}
+		labels[key] = strings.TrimSpace(string(value))
+	}
+	return nil
+}
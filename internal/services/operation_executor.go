@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+)
+
+const defaultOperationTimeout = time.Minute
+
+var (
+	ErrOperationQueueFull = errors.New("operation queue is full")
+	ErrExecutorStopped    = errors.New("operation executor is stopped")
+)
+
+type operation struct {
+	key     string
+	name    string
+	timeout time.Duration
+	run     func(ctx context.Context)
+}
+
+type OperationQueueStats struct {
+	Workers  int
+	Capacity int
+	Queued   int
+	Running  int
+}
+
+// OperationExecutor runs operations on a bounded pool of workers.
+// Operations sharing a key (container name) run one at a time in submission order,
+// each one gets its own deadline and all of them are cancelled when the executor stops.
+type OperationExecutor struct {
+	workers  int
+	capacity int
+	timeout  time.Duration
+	ready    chan *operation
+	pending  map[string][]*operation
+	active   map[string]bool
+	queued   int
+	running  int
+	stopped  bool
+	lock     sync.Mutex
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+func NewOperationExecutor(workers, capacity int, timeout time.Duration) *OperationExecutor {
+	if timeout <= 0 {
+		timeout = defaultOperationTimeout
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &OperationExecutor{
+		workers:  max(workers, 1),
+		capacity: max(capacity, 1),
+		timeout:  timeout,
+		ready:    make(chan *operation, max(capacity, 1)),
+		pending:  make(map[string][]*operation),
+		active:   make(map[string]bool),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (e *OperationExecutor) Start() {
+	for i := 0; i < e.workers; i++ {
+		e.wg.Add(1)
+		go e.work()
+	}
+}
+
+// Submit queues the operation, timeout of 0 falls back to the executor default
+func (e *OperationExecutor) Submit(key, name string, timeout time.Duration, run func(ctx context.Context)) error {
+	if timeout <= 0 {
+		timeout = e.timeout
+	}
+	op := &operation{key: key, name: name, timeout: timeout, run: run}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.stopped {
+		return ErrExecutorStopped
+	}
+	if e.queued >= e.capacity {
+		log.Printf("Rejecting %s operation on %s, %d operations queued", name, key, e.queued)
+		return ErrOperationQueueFull
+	}
+	e.queued++
+	if e.active[key] {
+		e.pending[key] = append(e.pending[key], op)
+		return nil
+	}
+	e.active[key] = true
+	e.ready <- op
+	return nil
+}
+
+func (e *OperationExecutor) work() {
+	defer e.wg.Done()
+	for {
+		select {
+		case op := <-e.ready:
+			e.execute(op)
+		case <-e.ctx.Done():
+			return
+		}
+	}
+}
+
+func (e *OperationExecutor) execute(op *operation) {
+	e.lock.Lock()
+	e.queued--
+	e.running++
+	e.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(e.ctx, op.timeout)
+	start := time.Now()
+	op.run(ctx)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		log.Printf("%s operation on %s exceeded its deadline of %s", op.name, op.key, op.timeout)
+	}
+	cancel()
+	log.Printf("%s operation on %s finished in %s", op.name, op.key, time.Since(start))
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.running--
+	if next := e.pending[op.key]; len(next) > 0 {
+		e.pending[op.key] = next[1:]
+		e.ready <- next[0]
+		return
+	}
+	delete(e.pending, op.key)
+	delete(e.active, op.key)
+}
+
+func (e *OperationExecutor) Stats() OperationQueueStats {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return OperationQueueStats{
+		Workers:  e.workers,
+		Capacity: e.capacity,
+		Queued:   e.queued,
+		Running:  e.running,
+	}
+}
+
+// Stop cancels running operations, drops queued ones and waits for the workers to exit
+func (e *OperationExecutor) Stop() {
+	e.lock.Lock()
+	e.stopped = true
+	e.lock.Unlock()
+	e.cancel()
+	e.wg.Wait()
+}
@@ -1,114 +1,152 @@
 package services
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
-	"strconv"
+	"math/rand/v2"
+	"time"
 
 	magnetarapi "github.com/c12s/magnetar/pkg/api"
 	"github.com/c12s/star/internal/domain"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	minRegistrationBackoff = time.Second
+	maxRegistrationBackoff = time.Minute
 )
 
 type RegistrationService struct {
-	client     *magnetarapi.RegistrationAsyncClient
+	conn       *nats.Conn
 	nodeIdRepo domain.NodeIdStore
+	labels     *LabelProviderRegistry
+	resources  *ResourceTracker
+	timeout    time.Duration
 }
 
-func NewRegistrationService(client *magnetarapi.RegistrationAsyncClient, nodeIdRepo domain.NodeIdStore) *RegistrationService {
+func NewRegistrationService(conn *nats.Conn, nodeIdRepo domain.NodeIdStore, labels *LabelProviderRegistry, resources *ResourceTracker, timeout time.Duration) *RegistrationService {
 	return &RegistrationService{
-		client:     client,
+		conn:       conn,
 		nodeIdRepo: nodeIdRepo,
+		labels:     labels,
+		resources:  resources,
+		timeout:    timeout,
 	}
 }
 
-func (rs *RegistrationService) Register(maxRetries int8, bindAddress string) error {
-	req := rs.buildReq(bindAddress)
-	for attemptsLeft := maxRetries; attemptsLeft > 0; attemptsLeft-- {
-		errChan := make(chan error)
-		err := rs.tryRegister(req, errChan)
-		if err == nil {
-			err = <-errChan
-			if err == nil {
-				return nil
-			}
-		}
-		log.Println(err)
+// Register sends registration requests until magnetar replies, each attempt waits for the reply
+// up to the request timeout and attempts are spaced out by exponential backoff with jitter.
+// maxRetries is the number of attempts, 0 makes a single attempt without retrying and
+// a negative one, e.g. -1, retries until ctx is done.
+func (rs *RegistrationService) Register(ctx context.Context, maxRetries int8, bindAddress string) error {
+	data, err := rs.buildReq(bindAddress).Marshal()
+	if err != nil {
+		return err
 	}
-	return errors.New("max registration attempts exceeded")
-}
-
-func (rs *RegistrationService) tryRegister(req *magnetarapi.RegistrationReq, errChan chan<- error) error {
-	err := rs.client.Register(req, func(resp *magnetarapi.RegistrationResp) {
-		errChan <- rs.nodeIdRepo.Put(domain.NodeId{Value: resp.NodeId})
-	})
-	return err
-}
-
-func (rs *RegistrationService) buildReq(bindAddress string) *magnetarapi.RegistrationReq {
-	builder := magnetarapi.NewRegistrationReqBuilder()
-	cpuCores, err := cpuCores()
-	if err == nil {
-		builder = builder.AddFloat64Label("cpu-cores", cpuCores)
-	}
-	for coreId := 0; coreId < int(cpuCores); coreId++ {
-		mhz, err := coreMhz(strconv.Itoa(coreId))
+	attempts := max(int(maxRetries), 1)
+	backoff := minRegistrationBackoff
+	for attempt := 1; maxRetries < 0 || attempt <= attempts; attempt++ {
+		log.Printf("Registering node with magnetar, attempt %d%s", attempt, attemptsLimit(maxRetries, attempts))
+		err := rs.attempt(ctx, data)
 		if err == nil {
-			builder = builder.AddFloat64Label(fmt.Sprintf("core%dmhz", coreId), mhz)
+			return nil
 		}
-		vendorId, err := coreVendorId(strconv.Itoa(coreId))
-		if err == nil {
-			builder = builder.AddStringLabel(fmt.Sprintf("core%dvendorId", coreId), vendorId)
+		if ctx.Err() != nil {
+			return ctx.Err()
 		}
-		model, err := coreModel(strconv.Itoa(coreId))
-		if err == nil {
-			builder = builder.AddStringLabel(fmt.Sprintf("core%dmodel", coreId), model)
+		if maxRetries >= 0 && attempt == attempts {
+			log.Printf("Registration attempt %d failed: %v", attempt, err)
+			break
 		}
-		cacheKB, err := coreCacheKB(strconv.Itoa(coreId))
-		if err == nil {
-			builder = builder.AddFloat64Label(fmt.Sprintf("core%dcacheKB", coreId), cacheKB)
+		wait := jitter(backoff)
+		log.Printf("Registration attempt %d failed: %v, retrying in %s", attempt, err, wait)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+		backoff = min(backoff*2, maxRegistrationBackoff)
 	}
-	fsType, err := fsType()
-	if err == nil {
-		builder = builder.AddStringLabel("fs-type", fsType)
-	}
-	diskTotalGB, err := diskTotalGB()
-	if err == nil {
-		builder = builder.AddFloat64Label("disk-totalGB", diskTotalGB)
-	}
-	diskFreeGB, err := diskFreeGB()
-	if err == nil {
-		builder = builder.AddFloat64Label("disk-freeGB", diskFreeGB)
-	}
-	kernelArch, err := kernelArch()
-	if err == nil {
-		builder = builder.AddStringLabel("kernel-arch", kernelArch)
-	}
-	kernelVersion, err := kernelVersion()
-	if err == nil {
-		builder = builder.AddStringLabel("kernel-version", kernelVersion)
+	return fmt.Errorf("registration failed after %d attempts", attempts)
+}
+
+// attempt sends a registration request and waits for its reply, the reply
+// subscription is dropped with the attempt so timed out attempts don't pile up
+func (rs *RegistrationService) attempt(ctx context.Context, data []byte) error {
+	replySubject := nats.NewInbox()
+	replies := make(chan *nats.Msg, 1)
+	subscription, err := rs.conn.ChanSubscribe(replySubject, replies)
+	if err != nil {
+		return err
 	}
-	platform, err := platform()
-	if err == nil {
-		builder = builder.AddStringLabel("platform", platform)
+	defer subscription.Unsubscribe()
+	err = rs.conn.PublishRequest(magnetarapi.RegistrationSubject, replySubject, data)
+	if err != nil {
+		return err
 	}
-	platformFamily, err := platformFamily()
-	if err == nil {
-		builder = builder.AddStringLabel("platform-family", platformFamily)
+
+	timer := time.NewTimer(rs.timeout)
+	defer timer.Stop()
+	select {
+	case msg := <-replies:
+		resp := &magnetarapi.RegistrationResp{}
+		err := resp.Unmarshal(msg.Data)
+		if err != nil {
+			return fmt.Errorf("invalid registration response: %w", err)
+		}
+		if resp.NodeId == "" {
+			return errors.New("magnetar replied without a node id")
+		}
+		err = rs.nodeIdRepo.Put(domain.NodeId{Value: resp.NodeId})
+		if err != nil {
+			return err
+		}
+		log.Printf("Node registered with id %s", resp.NodeId)
+		return nil
+	case <-timer.C:
+		return fmt.Errorf("no reply from magnetar within %s", rs.timeout)
+	case <-ctx.Done():
+		return ctx.Err()
 	}
-	platformVersion, err := platformVersion()
-	if err == nil {
-		builder = builder.AddStringLabel("platform-version", platformVersion)
+}
+
+func attemptsLimit(maxRetries int8, attempts int) string {
+	if maxRetries < 0 {
+		return ""
 	}
-	memoryTotalGB, err := memoryTotalGB()
-	if err == nil {
-		builder = builder.AddFloat64Label("memory-totalGB", memoryTotalGB)
+	return fmt.Sprintf("/%d", attempts)
+}
+
+// jitter returns a random duration between half of backoff and backoff,
+// so nodes started together don't retry in lockstep
+func jitter(backoff time.Duration) time.Duration {
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// buildReq reports the allocatable resources as cpu, mem and disk, what the node can still
+// give to apps, along with the capacity and the reserved resources they were computed from
+func (rs *RegistrationService) buildReq(bindAddress string) *magnetarapi.RegistrationReq {
+	ctx := context.Background()
+	builder := magnetarapi.NewRegistrationReqBuilder()
+	for _, label := range rs.labels.Collect(ctx) {
+		builder = label.addTo(builder)
 	}
 	req := builder.Request()
-	req.Resources["mem"] = memoryTotalGB
-	req.Resources["cpu"] = cpuCores
-	req.Resources["disk"] = diskFreeGB
+	resources, err := rs.resources.Resources(ctx)
+	if err != nil {
+		log.Printf("Failed to account for the node resources: %v", err)
+	}
+	req.Resources["cpu"] = resources.Allocatable.CPU
+	req.Resources["mem"] = resources.Allocatable.MemoryGB
+	req.Resources["disk"] = resources.Allocatable.DiskGB
+	req.Resources["cpu-capacity"] = resources.Capacity.CPU
+	req.Resources["mem-capacity"] = resources.Capacity.MemoryGB
+	req.Resources["disk-capacity"] = resources.Capacity.DiskGB
+	req.Resources["cpu-reserved"] = resources.Reserved.CPU
+	req.Resources["mem-reserved"] = resources.Reserved.MemoryGB
+	req.Resources["disk-reserved"] = resources.Reserved.DiskGB
 	req.BindAddress = bindAddress
 	return req
 }
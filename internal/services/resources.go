@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/shirou/gopsutil/cpu"
+	"github.com/shirou/gopsutil/disk"
+	"github.com/shirou/gopsutil/mem"
+)
+
+// NodeResources Allocatable is the Capacity less the resources Reserved by apps and the
+// SystemReserved ones, Apps holds the resources reserved by each app
+type NodeResources struct {
+	Capacity       domain.Resources
+	SystemReserved domain.Resources
+	Reserved       domain.Resources
+	Allocatable    domain.Resources
+	Apps           map[string]AppReservation
+}
+
+// AppReservation Namespace is empty for apps started without one
+type AppReservation struct {
+	Namespace string
+	Resources domain.Resources
+}
+
+// ReservedIn sums the resources reserved by the apps of a namespace
+func (r NodeResources) ReservedIn(namespace string) domain.Resources {
+	reserved := domain.Resources{}
+	for _, app := range r.Apps {
+		if app.Namespace == namespace {
+			reserved = reserved.Add(app.Resources)
+		}
+	}
+	return reserved
+}
+
+// ResourceTracker accounts for the resources reserved by the apps star started, read from the
+// AppResourcesLabel of their containers in the app index. Containers that aren't started
+// or exited don't reserve anything. Listeners are called when the allocatable resources change.
+type ResourceTracker struct {
+	index          *AppIndex
+	dataVolumePath string
+	systemReserved domain.Resources
+	capacity       func(ctx context.Context) (domain.Resources, error)
+	listeners      []func(resources NodeResources)
+	lock           sync.Mutex
+	allocatable    domain.Resources
+	notified       bool
+}
+
+func NewResourceTracker(index *AppIndex, dataVolumePath string, systemReserved domain.Resources) *ResourceTracker {
+	return &ResourceTracker{
+		index:          index,
+		dataVolumePath: dataVolumePath,
+		systemReserved: systemReserved,
+		capacity: func(ctx context.Context) (domain.Resources, error) {
+			return nodeCapacity(ctx, dataVolumePath)
+		},
+		listeners: make([]func(resources NodeResources), 0),
+	}
+}
+
+// AddListener registers a callback for changes of the allocatable resources,
+// it is also called the next time the resources are read
+func (t *ResourceTracker) AddListener(listener func(resources NodeResources)) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.listeners = append(t.listeners, listener)
+	t.notified = false
+}
+
+func (t *ResourceTracker) Resources(ctx context.Context) (NodeResources, error) {
+	capacity, err := t.capacity(ctx)
+	if err != nil {
+		return NodeResources{}, err
+	}
+	resources := NodeResources{
+		Capacity:       capacity,
+		SystemReserved: t.systemReserved,
+		Apps:           make(map[string]AppReservation),
+	}
+	for _, app := range t.index.All() {
+		reserved, ok := appReservation(app)
+		if !ok {
+			continue
+		}
+		resources.Apps[app.Name] = reserved
+		resources.Reserved = resources.Reserved.Add(reserved.Resources)
+	}
+	resources.Allocatable = capacity.Sub(resources.Reserved).Sub(t.systemReserved)
+
+	t.lock.Lock()
+	changed := !t.notified || resources.Allocatable != t.allocatable
+	t.allocatable = resources.Allocatable
+	t.notified = true
+	listeners := t.listeners
+	t.lock.Unlock()
+	if changed {
+		for _, listener := range listeners {
+			listener(resources)
+		}
+	}
+	return resources, nil
+}
+
+// DiskFreeGB is the free space left on the filesystem the disk capacity is taken from
+func (t *ResourceTracker) DiskFreeGB(ctx context.Context) (float64, error) {
+	usage, err := disk.UsageWithContext(ctx, dataDiskPath(t.dataVolumePath))
+	if err != nil {
+		return 0, err
+	}
+	return gigabytes(usage.Free), nil
+}
+
+// appReservation returns false for apps that don't reserve resources, containers that
+// were created but never started don't, e.g. ones left behind by a failed start
+func appReservation(app domain.AppContainer) (AppReservation, bool) {
+	if app.State == "created" || app.State == "exited" || app.State == "dead" {
+		return AppReservation{}, false
+	}
+	return labeledReservation(app)
+}
+
+// labeledReservation returns the resources an app container was created with, whatever its state
+func labeledReservation(app domain.AppContainer) (AppReservation, bool) {
+	data, ok := app.Labels[domain.AppResourcesLabel]
+	if !ok {
+		return AppReservation{}, false
+	}
+	reserved := domain.Resources{}
+	err := json.Unmarshal([]byte(data), &reserved)
+	if err != nil {
+		log.Printf("Failed to decode the resources reserved by %s: %v", app.Name, err)
+		return AppReservation{}, false
+	}
+	return AppReservation{
+		Namespace: app.Labels[domain.AppNamespaceLabel],
+		Resources: reserved,
+	}, true
+}
+
+func nodeCapacity(ctx context.Context, dataVolumePath string) (domain.Resources, error) {
+	limits, err := readCgroupLimits()
+	if err != nil {
+		log.Printf("Failed to read the cgroup limits: %v", err)
+	}
+	return capacityWithin(ctx, limits, dataDiskPath(dataVolumePath))
+}
+
+// capacityWithin bounds the host cpus and memory by the cgroup limits,
+// the disk capacity is the size of the filesystem holding diskPath, what apps reserve is
+// subtracted from it, so space used by anything else doesn't shrink what is allocatable
+func capacityWithin(ctx context.Context, limits cgroupLimits, diskPath string) (domain.Resources, error) {
+	cpuCores, err := cpu.CountsWithContext(ctx, true)
+	if err != nil {
+		return domain.Resources{}, err
+	}
+	cpuCapacity := float64(cpuCores)
+	if limits.cpusetCores > 0 {
+		cpuCapacity = min(cpuCapacity, float64(limits.cpusetCores))
+	}
+	if limits.cpuQuota > 0 {
+		cpuCapacity = min(cpuCapacity, limits.cpuQuota)
+	}
+	memInfo, err := mem.VirtualMemoryWithContext(ctx)
+	if err != nil {
+		return domain.Resources{}, err
+	}
+	memoryCapacity := memInfo.Total
+	if limits.memoryLimit > 0 {
+		memoryCapacity = min(memoryCapacity, limits.memoryLimit)
+	}
+	usage, err := disk.UsageWithContext(ctx, diskPath)
+	if err != nil {
+		return domain.Resources{}, err
+	}
+	return domain.Resources{
+		CPU:      cpuCapacity,
+		MemoryGB: gigabytes(memoryCapacity),
+		DiskGB:   gigabytes(usage.Total),
+	}, nil
+}
+
+// dataDiskPath falls back to the root filesystem when there's no data path or it doesn't exist
+func dataDiskPath(dataVolumePath string) string {
+	if dataVolumePath == "" {
+		return "/"
+	}
+	if _, err := os.Stat(dataVolumePath); err != nil {
+		return "/"
+	}
+	return dataVolumePath
+}
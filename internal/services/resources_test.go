@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+type fakeAppRuntime struct {
+	domain.AppRuntime
+	apps []domain.AppContainer
+}
+
+func (r *fakeAppRuntime) List(context.Context) ([]domain.AppContainer, error) {
+	return r.apps, nil
+}
+
+func (r *fakeAppRuntime) Inspect(_ context.Context, name string) (domain.AppContainer, error) {
+	for _, app := range r.apps {
+		if app.Name == name {
+			return app, nil
+		}
+	}
+	return domain.AppContainer{}, domain.ErrAppNotFound
+}
+
+func newTestAppIndex(runtime *fakeAppRuntime) *AppIndex {
+	index := NewAppIndex(runtime, time.Minute)
+	if err := index.Resync(context.Background()); err != nil {
+		panic(err)
+	}
+	return index
+}
+
+func newTestResourceTracker(capacity, systemReserved domain.Resources, apps ...domain.AppContainer) *ResourceTracker {
+	tracker := NewResourceTracker(newTestAppIndex(&fakeAppRuntime{apps: apps}), "", systemReserved)
+	tracker.capacity = func(context.Context) (domain.Resources, error) {
+		return capacity, nil
+	}
+	return tracker
+}
+
+func reservingApp(name, state, resources string) domain.AppContainer {
+	return domain.AppContainer{
+		Name:   name,
+		State:  state,
+		Labels: map[string]string{domain.AppResourcesLabel: resources},
+	}
+}
+
+func TestResourceTrackerSubtractsReservations(t *testing.T) {
+	tracker := newTestResourceTracker(
+		domain.Resources{CPU: 8, MemoryGB: 16, DiskGB: 100},
+		domain.Resources{CPU: 1, MemoryGB: 2},
+		reservingApp("web", "running", `{"cpu":2,"memoryGB":4,"diskGB":10}`),
+		reservingApp("db", "running", `{"cpu":1,"memoryGB":8}`),
+		reservingApp("failed", "created", `{"cpu":2}`),
+		reservingApp("job", "exited", `{"cpu":4}`),
+		domain.AppContainer{Name: "plain", State: "running"},
+	)
+
+	resources, err := tracker.Resources(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (domain.Resources{CPU: 3, MemoryGB: 12, DiskGB: 10}); resources.Reserved != want {
+		t.Fatalf("reserved = %+v, want %+v", resources.Reserved, want)
+	}
+	if want := (domain.Resources{CPU: 4, MemoryGB: 2, DiskGB: 90}); resources.Allocatable != want {
+		t.Fatalf("allocatable = %+v, want %+v", resources.Allocatable, want)
+	}
+	if len(resources.Apps) != 2 {
+		t.Fatalf("apps = %v, want web and db", resources.Apps)
+	}
+}
+
+func TestResourceTrackerNotifiesOnChange(t *testing.T) {
+	runtime := &fakeAppRuntime{}
+	index := newTestAppIndex(runtime)
+	tracker := NewResourceTracker(index, "", domain.Resources{})
+	tracker.capacity = func(context.Context) (domain.Resources, error) {
+		return domain.Resources{CPU: 4}, nil
+	}
+	notified := make([]domain.Resources, 0)
+	tracker.AddListener(func(resources NodeResources) {
+		notified = append(notified, resources.Allocatable)
+	})
+
+	tracker.Resources(context.Background())
+	tracker.Resources(context.Background())
+	runtime.apps = []domain.AppContainer{reservingApp("web", "running", `{"cpu":1}`)}
+	index.Resync(context.Background())
+	tracker.Resources(context.Background())
+
+	if len(notified) != 2 || notified[0].CPU != 4 || notified[1].CPU != 3 {
+		t.Fatalf("notified = %v, want cpu 4 then 3", notified)
+	}
+}
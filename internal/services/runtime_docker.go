@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/errdefs"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// docker merges container labels with these attributes in event actors
+var nonLabelEventAttributes = []string{"name", "image", "exitCode", "signal", "execDuration"}
+
+// DockerRuntime runs app containers as docker containers labeled with their revision
+type DockerRuntime struct {
+	dockerClient *client.Client
+	puller       *ImagePuller
+	digests      map[string]string
+	digestLock   sync.Mutex
+}
+
+func NewDockerRuntime(dockerClient *client.Client, puller *ImagePuller) *DockerRuntime {
+	return &DockerRuntime{
+		dockerClient: dockerClient,
+		puller:       puller,
+		digests:      make(map[string]string),
+	}
+}
+
+// dockerError lets callers tell missing and conflicting containers apart without knowing about docker
+func dockerError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errdefs.IsNotFound(err):
+		return fmt.Errorf("%w: %s", domain.ErrAppNotFound, err)
+	case errdefs.IsConflict(err):
+		return fmt.Errorf("%w: %s", domain.ErrAppConflict, err)
+	}
+	return err
+}
+
+func (r *DockerRuntime) Name() string {
+	return "docker"
+}
+
+func (r *DockerRuntime) Version(ctx context.Context) (string, error) {
+	version, err := r.dockerClient.ServerVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+	return version.Version, nil
+}
+
+func (r *DockerRuntime) EnsureImage(ctx context.Context, image string, policy domain.ImagePullPolicy) error {
+	if image == "" {
+		return errors.New("no image given and no default image configured")
+	}
+	return r.puller.EnsureImage(ctx, image, policy)
+}
+
+func (r *DockerRuntime) Create(ctx context.Context, spec domain.AppSpec) (string, error) {
+	containerConfig := &container.Config{
+		Image:      spec.Image,
+		Cmd:        spec.Command,
+		Env:        spec.Env,
+		WorkingDir: spec.WorkingDir,
+		Labels:     spec.Labels,
+		StopSignal: spec.StopSignal,
+	}
+	if len(containerConfig.Cmd) == 0 {
+		containerConfig.Cmd = []string{"ash", "-c", "while true; do sleep 1000; done"}
+	}
+	if spec.StopGracePeriod > 0 {
+		stopTimeout := int(spec.StopGracePeriod.Seconds())
+		containerConfig.StopTimeout = &stopTimeout
+	}
+	// docker has no disk limit that works with every storage driver, the disk is only reserved
+	hostConfig := &container.HostConfig{
+		Resources: container.Resources{
+			NanoCPUs: int64(spec.Resources.CPU * 1e9),
+			Memory:   int64(spec.Resources.MemoryGB * 1e9),
+		},
+	}
+	resp, err := r.dockerClient.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, spec.Name)
+	return resp.ID, dockerError(err)
+}
+
+func (r *DockerRuntime) Start(ctx context.Context, name string) error {
+	return dockerError(r.dockerClient.ContainerStart(ctx, name, container.StartOptions{}))
+}
+
+// stopOptions leaves out what the request didn't set,
+// so docker uses the stop signal and timeout of the container
+func stopOptions(options domain.StopOptions) container.StopOptions {
+	stopOptions := container.StopOptions{Signal: options.Signal}
+	if options.GracePeriod > 0 {
+		timeout := int(options.GracePeriod.Seconds())
+		stopOptions.Timeout = &timeout
+	}
+	return stopOptions
+}
+
+func (r *DockerRuntime) Stop(ctx context.Context, name string, options domain.StopOptions) error {
+	return dockerError(r.dockerClient.ContainerStop(ctx, name, stopOptions(options)))
+}
+
+func (r *DockerRuntime) Restart(ctx context.Context, name string, options domain.StopOptions) error {
+	return dockerError(r.dockerClient.ContainerRestart(ctx, name, stopOptions(options)))
+}
+
+func (r *DockerRuntime) Pause(ctx context.Context, name string) error {
+	return dockerError(r.dockerClient.ContainerPause(ctx, name))
+}
+
+func (r *DockerRuntime) Unpause(ctx context.Context, name string) error {
+	return dockerError(r.dockerClient.ContainerUnpause(ctx, name))
+}
+
+func (r *DockerRuntime) Remove(ctx context.Context, name string) error {
+	return dockerError(r.dockerClient.ContainerRemove(ctx, name, container.RemoveOptions{RemoveVolumes: true}))
+}
+
+func (r *DockerRuntime) Inspect(ctx context.Context, name string) (domain.AppContainer, error) {
+	info, err := r.dockerClient.ContainerInspect(ctx, name)
+	if err != nil {
+		return domain.AppContainer{}, dockerError(err)
+	}
+	return r.appFromInspect(ctx, info), nil
+}
+
+// List falls back to what the container list reports for containers that can't be inspected
+func (r *DockerRuntime) List(ctx context.Context) ([]domain.AppContainer, error) {
+	args := filters.NewArgs(filters.KeyValuePair{Key: "label", Value: domain.AppRevisionLabel})
+	containers, err := r.dockerClient.ContainerList(ctx, container.ListOptions{All: true, Filters: args})
+	if err != nil {
+		return nil, err
+	}
+	apps := make([]domain.AppContainer, 0, len(containers))
+	for _, listed := range containers {
+		info, err := r.dockerClient.ContainerInspect(ctx, listed.ID)
+		if errdefs.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			apps = append(apps, appFromList(listed))
+			continue
+		}
+		apps = append(apps, r.appFromInspect(ctx, info))
+	}
+	return apps, nil
+}
+
+func appFromList(listed types.Container) domain.AppContainer {
+	return domain.AppContainer{
+		Id:      listed.ID,
+		Name:    containerName(listed.Names),
+		Labels:  listed.Labels,
+		Image:   listed.Image,
+		State:   listed.State,
+		Running: listed.State == "running" || listed.State == "paused",
+		Paused:  listed.State == "paused",
+	}
+}
+
+func (r *DockerRuntime) appFromInspect(ctx context.Context, info types.ContainerJSON) domain.AppContainer {
+	app := domain.AppContainer{
+		Id:          info.ID,
+		Name:        strings.TrimPrefix(info.Name, "/"),
+		Labels:      info.Config.Labels,
+		Image:       info.Config.Image,
+		ImageDigest: r.imageDigest(ctx, info.Image),
+		State:       info.State.Status,
+		Running:     info.State.Running,
+		Paused:      info.State.Paused,
+		ExitCode:    int64(info.State.ExitCode),
+		IPAddresses: make([]string, 0),
+		Ports:       make([]domain.AppPort, 0),
+	}
+	// docker reports zero times as 0001-01-01T00:00:00Z
+	if startedAt, err := time.Parse(time.RFC3339Nano, info.State.StartedAt); err == nil && startedAt.Year() > 1 {
+		app.StartedAt = startedAt
+	}
+	if finishedAt, err := time.Parse(time.RFC3339Nano, info.State.FinishedAt); err == nil && finishedAt.Year() > 1 {
+		app.FinishedAt = finishedAt
+	}
+	if info.State.Health != nil {
+		app.Health = info.State.Health.Status
+	}
+	if info.NetworkSettings == nil {
+		return app
+	}
+	for _, network := range info.NetworkSettings.Networks {
+		if network.IPAddress != "" {
+			app.IPAddresses = append(app.IPAddresses, network.IPAddress)
+		}
+		if network.GlobalIPv6Address != "" {
+			app.IPAddresses = append(app.IPAddresses, network.GlobalIPv6Address)
+		}
+	}
+	for port, bindings := range info.NetworkSettings.Ports {
+		appPort := domain.AppPort{
+			ContainerPort: port.Int(),
+			Protocol:      port.Proto(),
+		}
+		if len(bindings) == 0 {
+			app.Ports = append(app.Ports, appPort)
+		}
+		for _, binding := range bindings {
+			appPort.HostIP = binding.HostIP
+			appPort.HostPort, _ = strconv.Atoi(binding.HostPort)
+			app.Ports = append(app.Ports, appPort)
+		}
+	}
+	sort.Slice(app.Ports, func(a, b int) bool {
+		return app.Ports[a].ContainerPort < app.Ports[b].ContainerPort
+	})
+	return app
+}
+
+// imageDigest prefers the repository digest of the image, images are
+// immutable so the result is cached by image id
+func (r *DockerRuntime) imageDigest(ctx context.Context, imageId string) string {
+	r.digestLock.Lock()
+	digest, ok := r.digests[imageId]
+	r.digestLock.Unlock()
+	if ok {
+		return digest
+	}
+	info, _, err := r.dockerClient.ImageInspectWithRaw(ctx, imageId)
+	if err != nil {
+		return imageId
+	}
+	digest = imageId
+	if len(info.RepoDigests) > 0 {
+		_, digest, _ = strings.Cut(info.RepoDigests[0], "@")
+	}
+	r.digestLock.Lock()
+	r.digests[imageId] = digest
+	r.digestLock.Unlock()
+	return digest
+}
+
+func (r *DockerRuntime) Exec(ctx context.Context, name string, cmd []string) (int64, error) {
+	exec, err := r.dockerClient.ContainerExecCreate(ctx, name, container.ExecOptions{
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+	})
+	if err != nil {
+		return 0, dockerError(err)
+	}
+	attach, err := r.dockerClient.ContainerExecAttach(ctx, exec.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return 0, err
+	}
+	defer attach.Close()
+	// the output is drained so the command can't block on a full pipe
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(io.Discard, attach.Reader)
+		done <- err
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	if err != nil {
+		return 0, err
+	}
+	inspect, err := r.dockerClient.ContainerExecInspect(ctx, exec.ID)
+	if err != nil {
+		return 0, err
+	}
+	return int64(inspect.ExitCode), nil
+}
+
+func (r *DockerRuntime) Logs(ctx context.Context, name string, options domain.AppLogsOptions, stdout, stderr io.Writer) error {
+	logsOptions := container.LogsOptions{
+		ShowStdout: true,
+		ShowStderr: true,
+		Tail:       "all",
+		Follow:     options.Follow,
+	}
+	if options.TailLines > 0 {
+		logsOptions.Tail = strconv.FormatInt(options.TailLines, 10)
+	}
+	if !options.Since.IsZero() {
+		logsOptions.Since = fmt.Sprintf("%d.%09d", options.Since.Unix(), options.Since.Nanosecond())
+	}
+	reader, err := r.dockerClient.ContainerLogs(ctx, name, logsOptions)
+	if err != nil {
+		return dockerError(err)
+	}
+	defer reader.Close()
+	// app containers are created without a TTY, so the log stream is multiplexed
+	_, err = stdcopy.StdCopy(stdout, stderr, reader)
+	return err
+}
+
+func (r *DockerRuntime) Stats(ctx context.Context, name string) (domain.AppStats, error) {
+	reader, err := r.dockerClient.ContainerStats(ctx, name, false)
+	if err != nil {
+		return domain.AppStats{}, dockerError(err)
+	}
+	defer reader.Body.Close()
+
+	stats := container.StatsResponse{}
+	err = json.NewDecoder(reader.Body).Decode(&stats)
+	if err != nil {
+		return domain.AppStats{}, err
+	}
+
+	appStats := domain.AppStats{
+		CPUPercent:       cpuPercent(stats),
+		MemoryUsageBytes: memoryUsage(stats.MemoryStats),
+		MemoryLimitBytes: stats.MemoryStats.Limit,
+	}
+	for _, network := range stats.Networks {
+		appStats.NetworkRxBytes += network.RxBytes
+		appStats.NetworkTxBytes += network.TxBytes
+	}
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			appStats.BlockReadBytes += entry.Value
+		case "write":
+			appStats.BlockWriteBytes += entry.Value
+		}
+	}
+	return appStats, nil
+}
+
+// cpuPercent is computed the same way as in docker stats, 100% is one fully used core
+func cpuPercent(stats container.StatsResponse) float64 {
+	cpuDelta := float64(stats.CPUStats.CPUUsage.TotalUsage) - float64(stats.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(stats.CPUStats.SystemUsage) - float64(stats.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(stats.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(stats.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if cpuDelta <= 0 || systemDelta <= 0 {
+		return 0
+	}
+	return cpuDelta / systemDelta * onlineCPUs * 100
+}
+
+// memoryUsage excludes the page cache, like docker stats does
+func memoryUsage(stats container.MemoryStats) uint64 {
+	// cgroup v1
+	if cache, ok := stats.Stats["total_inactive_file"]; ok && cache < stats.Usage {
+		return stats.Usage - cache
+	}
+	// cgroup v2
+	if cache, ok := stats.Stats["inactive_file"]; ok && cache < stats.Usage {
+		return stats.Usage - cache
+	}
+	return stats.Usage
+}
+
+func (r *DockerRuntime) Events(ctx context.Context, since time.Time) (<-chan domain.AppEvent, <-chan error) {
+	args := filters.NewArgs(
+		filters.KeyValuePair{Key: "type", Value: string(events.ContainerEventType)},
+		filters.KeyValuePair{Key: "label", Value: domain.AppRevisionLabel},
+	)
+	messages, errs := r.dockerClient.Events(ctx, events.ListOptions{
+		Since:   fmt.Sprintf("%d.%09d", since.Unix(), since.Nanosecond()),
+		Filters: args,
+	})
+	appEvents := make(chan domain.AppEvent)
+	appErrs := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case msg := <-messages:
+				event, ok := appEventFromDocker(msg)
+				if !ok {
+					continue
+				}
+				select {
+				case appEvents <- event:
+				case <-ctx.Done():
+					appErrs <- ctx.Err()
+					return
+				}
+			case err := <-errs:
+				appErrs <- err
+				return
+			}
+		}
+	}()
+	return appEvents, appErrs
+}
+
+func appEventFromDocker(msg events.Message) (domain.AppEvent, bool) {
+	event := domain.AppEvent{
+		ContainerId: msg.Actor.ID,
+		Name:        msg.Actor.Attributes["name"],
+		Labels:      make(map[string]string),
+		Time:        time.Unix(0, msg.TimeNano),
+	}
+	for key, value := range msg.Actor.Attributes {
+		event.Labels[key] = value
+	}
+	for _, key := range nonLabelEventAttributes {
+		delete(event.Labels, key)
+	}
+
+	switch {
+	case msg.Action == events.ActionStart:
+		event.Type = domain.AppEventStarted
+	case msg.Action == events.ActionDie:
+		event.Type = domain.AppEventDied
+		exitCode, err := strconv.ParseInt(msg.Actor.Attributes["exitCode"], 10, 64)
+		if err == nil {
+			event.ExitCode = exitCode
+		}
+	case msg.Action == events.ActionOOM:
+		event.Type = domain.AppEventOOMKilled
+	case msg.Action == events.ActionPause:
+		event.Type = domain.AppEventPaused
+	case msg.Action == events.ActionUnPause:
+		event.Type = domain.AppEventUnpaused
+	case msg.Action == events.ActionDestroy:
+		event.Type = domain.AppEventRemoved
+	case strings.HasPrefix(string(msg.Action), string(events.ActionHealthStatus)):
+		event.Type = domain.AppEventHealthStatusChanged
+		event.HealthStatus = strings.TrimSpace(strings.TrimPrefix(string(msg.Action), string(events.ActionHealthStatus)+":"))
+	default:
+		return domain.AppEvent{}, false
+	}
+	return event, true
+}
+
+// when calling docker API, it returns container names with "/" prefix
+func containerName(names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(names[0], "/")
+}
@@ -0,0 +1,999 @@
+//go:build unix
+
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/process"
+)
+
+const (
+	processStateFileName     = "state.json"
+	processStdoutFileName    = "stdout.log"
+	processStderrFileName    = "stderr.log"
+	defaultProcessStopSignal = syscall.SIGTERM
+	defaultProcessGrace      = 10 * time.Second
+	processLogsPollInterval  = 500 * time.Millisecond
+	processLogMaxBytes       = 10 * 1024 * 1024
+	processLogReadChunkBytes = 64 * 1024
+	processEventsBufferSize  = 256
+)
+
+// app names become directory names, so they are restricted the same way docker restricts container names
+var processNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]+$`)
+
+var processSignals = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"KILL": syscall.SIGKILL,
+	"USR1": syscall.SIGUSR1,
+	"USR2": syscall.SIGUSR2,
+	"TERM": syscall.SIGTERM,
+}
+
+// processState is persisted in the app's directory, so apps and their labels survive a star restart
+type processState struct {
+	Id              string            `json:"id"`
+	Name            string            `json:"name"`
+	Image           string            `json:"image,omitempty"`
+	Command         []string          `json:"command"`
+	Env             []string          `json:"env,omitempty"`
+	WorkingDir      string            `json:"working_dir,omitempty"`
+	Labels          map[string]string `json:"labels"`
+	StopSignal      string            `json:"stop_signal,omitempty"`
+	StopGracePeriod time.Duration     `json:"stop_grace_period,omitempty"`
+	State           string            `json:"state"`
+	Pid             int               `json:"pid,omitempty"`
+	StartedAt       time.Time         `json:"started_at"`
+	FinishedAt      time.Time         `json:"finished_at"`
+	ExitCode        int64             `json:"exit_code"`
+}
+
+type processApp struct {
+	state processState
+	dir   string
+	// exited is closed when the running process is reaped
+	exited chan struct{}
+}
+
+// ProcessRuntime runs apps as local process groups instead of containers, for nodes without docker.
+// Every app has a directory under the root with its state file and its stdout and stderr logs.
+type ProcessRuntime struct {
+	rootDir     string
+	apps        map[string]*processApp
+	subscribers map[chan domain.AppEvent]struct{}
+	lock        sync.Mutex
+}
+
+func NewProcessRuntime(rootDir string) (*ProcessRuntime, error) {
+	err := os.MkdirAll(rootDir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	r := &ProcessRuntime{
+		rootDir:     rootDir,
+		apps:        make(map[string]*processApp),
+		subscribers: make(map[chan domain.AppEvent]struct{}),
+	}
+	entries, err := os.ReadDir(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		app, err := r.load(filepath.Join(rootDir, entry.Name()))
+		if err != nil {
+			log.Printf("Skipping process app %s: %v", entry.Name(), err)
+			continue
+		}
+		r.apps[app.state.Name] = app
+	}
+	return r, nil
+}
+
+// load recovers an app left by a previous run, its process can't be supervised anymore so it is
+// stopped if it outlived star, and the app is reported as exited
+func (r *ProcessRuntime) load(dir string) (*processApp, error) {
+	data, err := os.ReadFile(filepath.Join(dir, processStateFileName))
+	if err != nil {
+		return nil, err
+	}
+	app := &processApp{dir: dir}
+	err = json.Unmarshal(data, &app.state)
+	if err != nil {
+		return nil, err
+	}
+	if app.state.State != "running" && app.state.State != "paused" {
+		return app, nil
+	}
+	if killStaleProcess(app.state.Pid, app.state.StartedAt) {
+		log.Printf("Killed process %d of app %s left by a previous run", app.state.Pid, app.state.Name)
+		app.state.ExitCode = 128 + int64(syscall.SIGKILL)
+	}
+	app.state.State = "exited"
+	app.state.Pid = 0
+	app.state.FinishedAt = time.Now()
+	return app, r.save(app)
+}
+
+// killStaleProcess kills the group of pid only if the process was started together with the app,
+// the pid could have been reused by an unrelated process since
+func killStaleProcess(pid int, startedAt time.Time) bool {
+	if pid <= 0 {
+		return false
+	}
+	proc, err := process.NewProcess(int32(pid))
+	if err != nil {
+		return false
+	}
+	createdAt, err := proc.CreateTime()
+	if err != nil || time.UnixMilli(createdAt).Sub(startedAt).Abs() > time.Second {
+		return false
+	}
+	return syscall.Kill(-pid, syscall.SIGKILL) == nil
+}
+
+// save must be called with the lock held, or before the app is shared
+func (r *ProcessRuntime) save(app *processApp) error {
+	data, err := json.Marshal(app.state)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(app.dir, processStateFileName)
+	err = os.WriteFile(path+".tmp", data, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// find must be called with the lock held, apps are looked up by name first and then by id
+func (r *ProcessRuntime) find(nameOrId string) (*processApp, error) {
+	if app, ok := r.apps[nameOrId]; ok {
+		return app, nil
+	}
+	for _, app := range r.apps {
+		if app.state.Id == nameOrId {
+			return app, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: no such app: %s", domain.ErrAppNotFound, nameOrId)
+}
+
+// publish must be called with the lock held, so events come out in the order of the changes.
+// Slow subscribers lose events instead of blocking the runtime.
+func (r *ProcessRuntime) publish(app *processApp, eventType domain.AppEventType) {
+	event := domain.AppEvent{
+		Type:        eventType,
+		ContainerId: app.state.Id,
+		Name:        app.state.Name,
+		Labels:      maps.Clone(app.state.Labels),
+		ExitCode:    app.state.ExitCode,
+		Time:        time.Now(),
+	}
+	for subscriber := range r.subscribers {
+		select {
+		case subscriber <- event:
+		default:
+			log.Printf("Dropping %s event of app %s, subscriber is not keeping up", eventType, app.state.Name)
+		}
+	}
+}
+
+func (r *ProcessRuntime) Name() string {
+	return "process"
+}
+
+// Version is empty, the process runtime is part of star
+func (r *ProcessRuntime) Version(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+// EnsureImage has nothing to do, processes run from the node's filesystem
+func (r *ProcessRuntime) EnsureImage(ctx context.Context, image string, policy domain.ImagePullPolicy) error {
+	return nil
+}
+
+func (r *ProcessRuntime) Create(ctx context.Context, spec domain.AppSpec) (string, error) {
+	if !processNamePattern.MatchString(spec.Name) {
+		return "", fmt.Errorf("invalid app name: %s", spec.Name)
+	}
+	id, err := newProcessAppId()
+	if err != nil {
+		return "", err
+	}
+	app := &processApp{
+		dir: filepath.Join(r.rootDir, spec.Name),
+		state: processState{
+			Id:              id,
+			Name:            spec.Name,
+			Image:           spec.Image,
+			Command:         spec.Command,
+			Env:             spec.Env,
+			WorkingDir:      spec.WorkingDir,
+			Labels:          maps.Clone(spec.Labels),
+			StopSignal:      spec.StopSignal,
+			StopGracePeriod: spec.StopGracePeriod,
+			State:           "created",
+		},
+	}
+	if len(app.state.Command) == 0 {
+		app.state.Command = []string{"sh", "-c", "while true; do sleep 1000; done"}
+	}
+	if app.state.Labels == nil {
+		app.state.Labels = make(map[string]string)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.apps[spec.Name]; ok {
+		return "", fmt.Errorf("%w: app %s already exists", domain.ErrAppConflict, spec.Name)
+	}
+	err = os.MkdirAll(app.dir, 0755)
+	if err != nil {
+		return "", err
+	}
+	err = r.save(app)
+	if err != nil {
+		os.RemoveAll(app.dir)
+		return "", err
+	}
+	r.apps[spec.Name] = app
+	return id, nil
+}
+
+func newProcessAppId() (string, error) {
+	id := make([]byte, 32)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// Start does nothing for an app that is already running, like docker
+func (r *ProcessRuntime) Start(ctx context.Context, name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	app, err := r.find(name)
+	if err != nil {
+		return err
+	}
+	if app.state.State == "running" || app.state.State == "paused" {
+		return nil
+	}
+	return r.start(app)
+}
+
+// start must be called with the lock held
+func (r *ProcessRuntime) start(app *processApp) error {
+	cmd := exec.Command(app.state.Command[0], app.state.Command[1:]...)
+	cmd.Dir = app.state.WorkingDir
+	if cmd.Dir == "" {
+		cmd.Dir = app.dir
+	}
+	// apps don't inherit star's environment, it can hold registry credentials
+	cmd.Env = append([]string{"PATH=" + os.Getenv("PATH"), "HOME=" + app.dir}, app.state.Env...)
+	// apps run in their own process group, so signals reach the processes they spawn
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	stdout, err := r.logPipe(app, processStdoutFileName)
+	if err != nil {
+		return err
+	}
+	stderr, err := r.logPipe(app, processStderrFileName)
+	if err != nil {
+		stdout.Close()
+		return err
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err = cmd.Start()
+	// the child has its own copies, the log copiers see EOF once it and its children exit
+	stdout.Close()
+	stderr.Close()
+	if err != nil {
+		return fmt.Errorf("starting app %s: %w", app.state.Name, err)
+	}
+
+	app.state.State = "running"
+	app.state.Pid = cmd.Process.Pid
+	app.state.StartedAt = time.Now()
+	app.state.FinishedAt = time.Time{}
+	app.state.ExitCode = 0
+	app.exited = make(chan struct{})
+	if err := r.save(app); err != nil {
+		log.Printf("Failed to save state of app %s: %v", app.state.Name, err)
+	}
+	r.publish(app, domain.AppEventStarted)
+	go r.wait(app, cmd, app.exited)
+	return nil
+}
+
+// logPipe returns the write end of a pipe whose lines are appended to the log file with a timestamp
+func (r *ProcessRuntime) logPipe(app *processApp, fileName string) (*os.File, error) {
+	logFile, err := openProcessLog(filepath.Join(app.dir, fileName))
+	if err != nil {
+		return nil, err
+	}
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		logFile.file.Close()
+		return nil, err
+	}
+	go copyProcessLog(reader, logFile)
+	return writer, nil
+}
+
+func copyProcessLog(reader *os.File, logFile *processLogFile) {
+	defer reader.Close()
+	defer logFile.file.Close()
+	buffered := bufio.NewReader(reader)
+	for {
+		line, err := buffered.ReadBytes('\n')
+		if len(line) > 0 {
+			if line[len(line)-1] != '\n' {
+				line = append(line, '\n')
+			}
+			entry := append([]byte(time.Now().UTC().Format(time.RFC3339Nano)+" "), line...)
+			if writeErr := logFile.write(entry); writeErr != nil {
+				log.Printf("Failed to write app log %s: %v", logFile.path, writeErr)
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// processLogFile is moved to the rotated log path once a write would take it past processLogMaxBytes,
+// replacing the log rotated before, so an app's output takes at most twice that on disk
+type processLogFile struct {
+	path string
+	file *os.File
+	size int64
+}
+
+func openProcessLog(path string) (*processLogFile, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &processLogFile{path: path, file: file, size: info.Size()}, nil
+}
+
+func (f *processLogFile) write(entry []byte) error {
+	if f.size > 0 && f.size+int64(len(entry)) > processLogMaxBytes {
+		// the entry still goes to the full file when rotating fails, the log isn't lost
+		if err := f.rotate(); err != nil {
+			log.Printf("Failed to rotate app log %s: %v", f.path, err)
+		}
+	}
+	n, err := f.file.Write(entry)
+	f.size += int64(n)
+	return err
+}
+
+func (f *processLogFile) rotate() error {
+	err := os.Rename(f.path, rotatedProcessLog(f.path))
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	f.file.Close()
+	f.file, f.size = file, 0
+	return nil
+}
+
+func rotatedProcessLog(path string) string {
+	return path + ".1"
+}
+
+func (r *ProcessRuntime) wait(app *processApp, cmd *exec.Cmd, exited chan struct{}) {
+	cmd.Wait()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	app.state.State = "exited"
+	app.state.Pid = 0
+	app.state.FinishedAt = time.Now()
+	app.state.ExitCode = processExitCode(cmd.ProcessState)
+	close(exited)
+	if _, ok := r.apps[app.state.Name]; !ok {
+		return
+	}
+	if err := r.save(app); err != nil {
+		log.Printf("Failed to save state of app %s: %v", app.state.Name, err)
+	}
+	r.publish(app, domain.AppEventDied)
+}
+
+// processExitCode reports processes killed by a signal with 128+signal, the way docker does
+func processExitCode(state *os.ProcessState) int64 {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return 128 + int64(status.Signal())
+	}
+	return int64(state.ExitCode())
+}
+
+func parseProcessSignal(signal string) (syscall.Signal, error) {
+	if number, err := strconv.Atoi(signal); err == nil && number > 0 {
+		return syscall.Signal(number), nil
+	}
+	if sig, ok := processSignals[strings.TrimPrefix(strings.ToUpper(signal), "SIG")]; ok {
+		return sig, nil
+	}
+	return 0, fmt.Errorf("unsupported stop signal: %s", signal)
+}
+
+// Stop does nothing for an app that isn't running, like docker. The signal and grace period
+// of the app are used when the options don't give their own.
+func (r *ProcessRuntime) Stop(ctx context.Context, name string, options domain.StopOptions) error {
+	r.lock.Lock()
+	app, err := r.find(name)
+	if err != nil {
+		r.lock.Unlock()
+		return err
+	}
+	if app.state.State != "running" && app.state.State != "paused" {
+		r.lock.Unlock()
+		return nil
+	}
+	pid, exited := app.state.Pid, app.exited
+	signalName := options.Signal
+	if signalName == "" {
+		signalName = app.state.StopSignal
+	}
+	grace := options.GracePeriod
+	if grace <= 0 {
+		grace = app.state.StopGracePeriod
+	}
+	r.lock.Unlock()
+
+	signal := defaultProcessStopSignal
+	if signalName != "" {
+		signal, err = parseProcessSignal(signalName)
+		if err != nil {
+			return err
+		}
+	}
+	if grace <= 0 {
+		grace = defaultProcessGrace
+	}
+	return stopProcessGroup(ctx, pid, exited, signal, grace)
+}
+
+func stopProcessGroup(ctx context.Context, pid int, exited chan struct{}, signal syscall.Signal, grace time.Duration) error {
+	syscall.Kill(-pid, signal)
+	// a paused app has to be continued to handle the signal
+	syscall.Kill(-pid, syscall.SIGCONT)
+	timer := time.NewTimer(grace)
+	defer timer.Stop()
+	select {
+	case <-exited:
+		return nil
+	case <-timer.C:
+		log.Printf("Process %d did not exit within %s, killing it", pid, grace)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	syscall.Kill(-pid, syscall.SIGKILL)
+	select {
+	case <-exited:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (r *ProcessRuntime) Restart(ctx context.Context, name string, options domain.StopOptions) error {
+	err := r.Stop(ctx, name, options)
+	if err != nil {
+		return err
+	}
+	return r.Start(ctx, name)
+}
+
+func (r *ProcessRuntime) Pause(ctx context.Context, name string) error {
+	return r.signalState(name, "running", "paused", syscall.SIGSTOP, domain.AppEventPaused)
+}
+
+func (r *ProcessRuntime) Unpause(ctx context.Context, name string) error {
+	return r.signalState(name, "paused", "running", syscall.SIGCONT, domain.AppEventUnpaused)
+}
+
+func (r *ProcessRuntime) signalState(name, from, to string, signal syscall.Signal, eventType domain.AppEventType) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	app, err := r.find(name)
+	if err != nil {
+		return err
+	}
+	if app.state.State != from {
+		return fmt.Errorf("%w: app %s is %s", domain.ErrAppConflict, app.state.Name, app.state.State)
+	}
+	err = syscall.Kill(-app.state.Pid, signal)
+	if err != nil {
+		return err
+	}
+	app.state.State = to
+	if err := r.save(app); err != nil {
+		log.Printf("Failed to save state of app %s: %v", app.state.Name, err)
+	}
+	r.publish(app, eventType)
+	return nil
+}
+
+// Remove refuses to remove a running app, like docker
+func (r *ProcessRuntime) Remove(ctx context.Context, name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	app, err := r.find(name)
+	if err != nil {
+		return err
+	}
+	if app.state.State == "running" || app.state.State == "paused" {
+		return fmt.Errorf("%w: app %s is %s, stop it before removing", domain.ErrAppConflict, app.state.Name, app.state.State)
+	}
+	err = os.RemoveAll(app.dir)
+	if err != nil {
+		return err
+	}
+	delete(r.apps, app.state.Name)
+	r.publish(app, domain.AppEventRemoved)
+	return nil
+}
+
+func (r *ProcessRuntime) Inspect(ctx context.Context, name string) (domain.AppContainer, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	app, err := r.find(name)
+	if err != nil {
+		return domain.AppContainer{}, err
+	}
+	return app.container(), nil
+}
+
+func (r *ProcessRuntime) List(ctx context.Context) ([]domain.AppContainer, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	apps := make([]domain.AppContainer, 0, len(r.apps))
+	for _, app := range r.apps {
+		apps = append(apps, app.container())
+	}
+	return apps, nil
+}
+
+// container must be called with the lock held, processes share the node's network
+// so they have no addresses or ports of their own
+func (a *processApp) container() domain.AppContainer {
+	running := a.state.State == "running" || a.state.State == "paused"
+	return domain.AppContainer{
+		Id:          a.state.Id,
+		Name:        a.state.Name,
+		Labels:      maps.Clone(a.state.Labels),
+		Image:       a.state.Image,
+		State:       a.state.State,
+		Running:     running,
+		Paused:      a.state.State == "paused",
+		StartedAt:   a.state.StartedAt,
+		FinishedAt:  a.state.FinishedAt,
+		ExitCode:    a.state.ExitCode,
+		IPAddresses: make([]string, 0),
+		Ports:       make([]domain.AppPort, 0),
+	}
+}
+
+func (r *ProcessRuntime) Exec(ctx context.Context, name string, cmd []string) (int64, error) {
+	if len(cmd) == 0 {
+		return 0, errors.New("no command given")
+	}
+	r.lock.Lock()
+	app, err := r.find(name)
+	if err != nil {
+		r.lock.Unlock()
+		return 0, err
+	}
+	if app.state.State != "running" {
+		r.lock.Unlock()
+		return 0, fmt.Errorf("%w: app %s is %s", domain.ErrAppConflict, app.state.Name, app.state.State)
+	}
+	execCmd := exec.CommandContext(ctx, cmd[0], cmd[1:]...)
+	execCmd.Dir = app.state.WorkingDir
+	if execCmd.Dir == "" {
+		execCmd.Dir = app.dir
+	}
+	execCmd.Env = append([]string{"PATH=" + os.Getenv("PATH"), "HOME=" + app.dir}, app.state.Env...)
+	r.lock.Unlock()
+
+	err = execCmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return processExitCode(exitErr.ProcessState), nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+type processLogLine struct {
+	time   time.Time
+	data   []byte
+	stderr bool
+}
+
+// processLogCursor reads complete lines appended to a log file since the last read,
+// when the file was rotated in between, the rest of the rotated file is read first
+type processLogCursor struct {
+	path string
+	// file is the log file offset points into, nil until the log was first read
+	file   os.FileInfo
+	offset int64
+	stderr bool
+}
+
+// tail reads the last tailLines lines of the log, all of them when tailLines is 0, and leaves
+// the cursor after them. With tailLines set only the end of the log files is read.
+func (c *processLogCursor) tail(tailLines int64, since time.Time) ([]processLogLine, error) {
+	data, info, end, err := readProcessLogTail(c.path, tailLines)
+	if err != nil {
+		return nil, err
+	}
+	c.file, c.offset = info, end
+	count := int64(bytes.Count(data, []byte{'\n'}))
+	if tailLines == 0 || count < tailLines {
+		rotated, _, _, err := readProcessLogTail(rotatedProcessLog(c.path), max(tailLines-count, 0))
+		if err != nil {
+			return nil, err
+		}
+		data = append(rotated, data...)
+	}
+	return parseProcessLog(data, since, c.stderr), nil
+}
+
+func (c *processLogCursor) read(since time.Time) ([]processLogLine, error) {
+	file, err := os.Open(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0)
+	if c.file != nil && !os.SameFile(c.file, info) {
+		data, err = c.readRotated()
+		if err != nil {
+			return nil, err
+		}
+		c.offset = 0
+	}
+	c.file = info
+	appended, err := readProcessLogFrom(file, c.offset, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	c.offset += int64(len(appended))
+	return parseProcessLog(append(data, appended...), since, c.stderr), nil
+}
+
+// readRotated reads what was appended to the log before it was rotated,
+// nothing when it was rotated twice since the last read and that is gone
+func (c *processLogCursor) readRotated() ([]byte, error) {
+	file, err := os.Open(rotatedProcessLog(c.path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !os.SameFile(c.file, info) {
+		return nil, nil
+	}
+	return readProcessLogFrom(file, c.offset, info.Size())
+}
+
+// readProcessLogFrom reads the complete lines between offset and size,
+// a line still being written is left for the next read
+func readProcessLogFrom(file *os.File, offset, size int64) ([]byte, error) {
+	if size <= offset {
+		return nil, nil
+	}
+	data := make([]byte, size-offset)
+	n, err := file.ReadAt(data, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	data = data[:n]
+	return data[:bytes.LastIndexByte(data, '\n')+1], nil
+}
+
+// readProcessLogTail reads the last tailLines complete lines of a log file, all of them when tailLines is 0,
+// by scanning the file backwards from its end, along with the file info and where the lines end.
+// The file info is nil when there is no such file.
+func readProcessLogTail(path string, tailLines int64) ([]byte, os.FileInfo, int64, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, 0, nil
+	}
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	start, end, err := processLogTailOffsets(file, info.Size(), tailLines)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	data, err := readProcessLogFrom(file, start, end)
+	return data, info, start + int64(len(data)), err
+}
+
+// processLogTailOffsets returns where the last tailLines complete lines of the file start and where they end,
+// they start at 0 when tailLines is 0 or the file has fewer lines
+func processLogTailOffsets(file *os.File, size, tailLines int64) (int64, int64, error) {
+	chunk := make([]byte, processLogReadChunkBytes)
+	end := int64(-1)
+	lines := int64(0)
+	for position := size; position > 0; {
+		n := min(int64(len(chunk)), position)
+		position -= n
+		_, err := file.ReadAt(chunk[:n], position)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, 0, err
+		}
+		for i := n - 1; i >= 0; i-- {
+			if chunk[i] != '\n' {
+				continue
+			}
+			if end < 0 {
+				// a line still being written is left for the next read
+				end = position + i + 1
+				if tailLines == 0 {
+					return 0, end, nil
+				}
+				continue
+			}
+			lines++
+			if lines == tailLines {
+				return position + i + 1, end, nil
+			}
+		}
+	}
+	return 0, max(end, 0), nil
+}
+
+func parseProcessLog(data []byte, since time.Time, stderr bool) []processLogLine {
+	lines := make([]processLogLine, 0)
+	for _, entry := range bytes.SplitAfter(data, []byte{'\n'}) {
+		timestamp, line, ok := bytes.Cut(entry, []byte{' '})
+		if !ok {
+			continue
+		}
+		lineTime, err := time.Parse(time.RFC3339Nano, string(timestamp))
+		if err != nil || lineTime.Before(since) {
+			continue
+		}
+		lines = append(lines, processLogLine{time: lineTime, data: line, stderr: stderr})
+	}
+	return lines
+}
+
+func readProcessLogs(cursors []*processLogCursor, read func(cursor *processLogCursor) ([]processLogLine, error)) ([]processLogLine, error) {
+	lines := make([]processLogLine, 0)
+	for _, cursor := range cursors {
+		cursorLines, err := read(cursor)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, cursorLines...)
+	}
+	sort.SliceStable(lines, func(a, b int) bool {
+		return lines[a].time.Before(lines[b].time)
+	})
+	return lines, nil
+}
+
+func writeProcessLogs(lines []processLogLine, stdout, stderr io.Writer) error {
+	for _, line := range lines {
+		writer := stdout
+		if line.stderr {
+			writer = stderr
+		}
+		_, err := writer.Write(line.data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Logs follows by polling the log files, it returns once the app has exited
+// and everything it wrote was copied, or when ctx is done
+func (r *ProcessRuntime) Logs(ctx context.Context, name string, options domain.AppLogsOptions, stdout, stderr io.Writer) error {
+	r.lock.Lock()
+	app, err := r.find(name)
+	if err != nil {
+		r.lock.Unlock()
+		return err
+	}
+	id := app.state.Id
+	cursors := []*processLogCursor{
+		{path: filepath.Join(app.dir, processStdoutFileName)},
+		{path: filepath.Join(app.dir, processStderrFileName), stderr: true},
+	}
+	r.lock.Unlock()
+
+	// the last lines of the merged logs are among the last lines of each log
+	lines, err := readProcessLogs(cursors, func(cursor *processLogCursor) ([]processLogLine, error) {
+		return cursor.tail(options.TailLines, options.Since)
+	})
+	if err != nil {
+		return err
+	}
+	if options.TailLines > 0 && int64(len(lines)) > options.TailLines {
+		lines = lines[int64(len(lines))-options.TailLines:]
+	}
+	err = writeProcessLogs(lines, stdout, stderr)
+	if err != nil || !options.Follow {
+		return err
+	}
+
+	ticker := time.NewTicker(processLogsPollInterval)
+	defer ticker.Stop()
+	// the log copiers can lag behind the exit, so the files are read once more after it
+	exited := false
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		lines, err := readProcessLogs(cursors, func(cursor *processLogCursor) ([]processLogLine, error) {
+			return cursor.read(options.Since)
+		})
+		if err != nil {
+			return err
+		}
+		err = writeProcessLogs(lines, stdout, stderr)
+		if err != nil || exited {
+			return err
+		}
+		exited = !r.running(id)
+	}
+}
+
+func (r *ProcessRuntime) running(id string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	app, err := r.find(id)
+	return err == nil && (app.state.State == "running" || app.state.State == "paused")
+}
+
+// Stats covers the app's main process, the CPU usage is sampled over a second.
+// Processes share the node's network, so no network traffic is reported.
+func (r *ProcessRuntime) Stats(ctx context.Context, name string) (domain.AppStats, error) {
+	r.lock.Lock()
+	app, err := r.find(name)
+	if err != nil {
+		r.lock.Unlock()
+		return domain.AppStats{}, err
+	}
+	pid := app.state.Pid
+	r.lock.Unlock()
+	if pid == 0 {
+		return domain.AppStats{}, nil
+	}
+
+	proc, err := process.NewProcessWithContext(ctx, int32(pid))
+	if err != nil {
+		return domain.AppStats{}, err
+	}
+	stats := domain.AppStats{}
+	stats.CPUPercent, err = proc.PercentWithContext(ctx, time.Second)
+	if err != nil {
+		return domain.AppStats{}, err
+	}
+	memoryInfo, err := proc.MemoryInfoWithContext(ctx)
+	if err != nil {
+		return domain.AppStats{}, err
+	}
+	stats.MemoryUsageBytes = memoryInfo.RSS
+	if virtualMemory, err := mem.VirtualMemoryWithContext(ctx); err == nil {
+		stats.MemoryLimitBytes = virtualMemory.Total
+	}
+	// reading another process's IO counters can need privileges star doesn't have
+	if ioCounters, err := proc.IOCountersWithContext(ctx); err == nil {
+		stats.BlockReadBytes = ioCounters.ReadBytes
+		stats.BlockWriteBytes = ioCounters.WriteBytes
+	}
+	return stats, nil
+}
+
+// Events only delivers events that happen after the call, processes
+// don't outlive star so there is nothing to replay from since
+func (r *ProcessRuntime) Events(ctx context.Context, since time.Time) (<-chan domain.AppEvent, <-chan error) {
+	appEvents := make(chan domain.AppEvent, processEventsBufferSize)
+	errs := make(chan error, 1)
+	r.lock.Lock()
+	r.subscribers[appEvents] = struct{}{}
+	r.lock.Unlock()
+	go func() {
+		<-ctx.Done()
+		r.lock.Lock()
+		delete(r.subscribers, appEvents)
+		r.lock.Unlock()
+		errs <- ctx.Err()
+	}()
+	return appEvents, errs
+}
+
+// StopAll stops every running app with its own stop signal and grace period, it is called on shutdown
+func (r *ProcessRuntime) StopAll(ctx context.Context) {
+	r.lock.Lock()
+	names := make([]string, 0, len(r.apps))
+	for name, app := range r.apps {
+		if app.state.State == "running" || app.state.State == "paused" {
+			names = append(names, name)
+		}
+	}
+	r.lock.Unlock()
+	var wg sync.WaitGroup
+	for _, name := range names {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			if err := r.Stop(ctx, name, domain.StopOptions{}); err != nil {
+				log.Printf("Failed to stop app %s: %v", name, err)
+			}
+		}(name)
+	}
+	wg.Wait()
+}
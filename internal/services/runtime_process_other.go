@@ -0,0 +1,21 @@
+//go:build !unix
+
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+// ProcessRuntime needs process groups and signals, which are only available on unix systems
+type ProcessRuntime struct {
+	domain.AppRuntime
+}
+
+func NewProcessRuntime(rootDir string) (*ProcessRuntime, error) {
+	return nil, errors.New("the process runtime is only supported on unix systems")
+}
+
+func (r *ProcessRuntime) StopAll(ctx context.Context) {}
@@ -0,0 +1,89 @@
+//go:build unix
+
+package services
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeTestProcessLog(t *testing.T, logFile *processLogFile, lines ...string) {
+	t.Helper()
+	for _, line := range lines {
+		entry := time.Now().UTC().Format(time.RFC3339Nano) + " " + line + "\n"
+		if err := logFile.write([]byte(entry)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func processLogText(lines []processLogLine) string {
+	var text strings.Builder
+	for _, line := range lines {
+		text.Write(line.data)
+	}
+	return text.String()
+}
+
+func TestProcessLogTailsAcrossRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), processStdoutFileName)
+	logFile, err := openProcessLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { logFile.file.Close() }()
+	writeTestProcessLog(t, logFile, "one", "two", "three")
+	if err := logFile.rotate(); err != nil {
+		t.Fatal(err)
+	}
+	writeTestProcessLog(t, logFile, "four")
+
+	cursor := &processLogCursor{path: path}
+	lines, err := cursor.tail(2, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := processLogText(lines); text != "three\nfour\n" {
+		t.Fatalf("expected the last two lines from both files, got %q", text)
+	}
+
+	writeTestProcessLog(t, logFile, "five")
+	if err := logFile.rotate(); err != nil {
+		t.Fatal(err)
+	}
+	writeTestProcessLog(t, logFile, "six")
+	lines, err = cursor.read(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text := processLogText(lines); text != "five\nsix\n" {
+		t.Fatalf("expected the lines written before and after the rotation, got %q", text)
+	}
+}
+
+func TestProcessLogRotatesPastMaxBytes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), processStdoutFileName)
+	logFile, err := openProcessLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { logFile.file.Close() }()
+	line := strings.Repeat("x", 1024)
+	for i := 0; i < 3*processLogMaxBytes/len(line); i++ {
+		writeTestProcessLog(t, logFile, fmt.Sprintf("%d %s", i, line))
+	}
+
+	for _, logPath := range []string{path, rotatedProcessLog(path)} {
+		info, err := os.Stat(logPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size() > processLogMaxBytes {
+			t.Fatalf("expected %s to stay within %d bytes, it has %d", logPath, processLogMaxBytes, info.Size())
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -25,19 +26,24 @@ type SerfAgent struct {
 	eventChannel   chan serf.Event
 	stopChannel    chan struct{}
 	Wg             sync.WaitGroup
+	leaveOnce      sync.Once
 	nc             *nats.Conn
 	payloadBacklog map[string]string
 	nodeId         string
 	configs        domain.ConfigStore
+	// labels and resources make up the tags with the node id
+	labels    map[string]string
+	resources map[string]string
+	tagsLock  sync.Mutex
 }
 
 // NewSerfAgent payloadBacklog is needed only if the  payload splitting option is used
-func NewSerfAgent(cf *configs.Config, nc *nats.Conn, nodeId string, configs domain.ConfigStore) (*SerfAgent, error) {
+func NewSerfAgent(cf *configs.Config, nc *nats.Conn, nodeId string, configs domain.ConfigStore, labels map[string]string) (*SerfAgent, error) {
 	serfConfig := serf.DefaultConfig()
 	serfChannel := make(chan serf.Event)
-	tags, err := createTags(nodeId)
+	tags, err := createTags(nodeId, labels, nil)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 	serfConfig.EventCh = serfChannel
 	// todo: node id
@@ -60,6 +66,7 @@ func NewSerfAgent(cf *configs.Config, nc *nats.Conn, nodeId string, configs doma
 		payloadBacklog: make(map[string]string),
 		nodeId:         nodeId,
 		configs:        configs,
+		labels:         labels,
 	}, nil
 }
 
@@ -71,13 +78,16 @@ func (s *SerfAgent) Join(joinAddress string) error {
 	return nil
 }
 
+// Leave can be called more than once, a decommissioned node leaves before star is stopped
 func (s *SerfAgent) Leave() {
-	close(s.stopChannel)
-	s.Wg.Wait()
-	err := s.agent.Leave()
-	if err != nil {
-		log.Println(err)
-	}
+	s.leaveOnce.Do(func() {
+		close(s.stopChannel)
+		s.Wg.Wait()
+		err := s.agent.Leave()
+		if err != nil {
+			log.Println(err)
+		}
+	})
 }
 
 func (s *SerfAgent) Listen() {
@@ -270,9 +280,89 @@ func handleUser(ev serf.Event, s *SerfAgent) {
 	}
 }
 
-// createTags adds the config tags to the serf agent
-func createTags(nodeId string) (map[string]string, error) {
-	return map[string]string{"node_id": nodeId}, nil
+// SetLabels replaces the node labels in the agent's tags and gossips them to the cluster
+func (s *SerfAgent) SetLabels(labels map[string]string) {
+	s.tagsLock.Lock()
+	defer s.tagsLock.Unlock()
+	s.labels = labels
+	s.updateTags()
+}
+
+// SetResources gossips the allocatable resources of the node as the cpu-allocatable,
+// mem-allocatable and disk-allocatable tags
+func (s *SerfAgent) SetResources(resources NodeResources) {
+	s.tagsLock.Lock()
+	defer s.tagsLock.Unlock()
+	s.resources = map[string]string{
+		"cpu-allocatable":  strconv.FormatFloat(resources.Allocatable.CPU, 'f', -1, 64),
+		"mem-allocatable":  strconv.FormatFloat(resources.Allocatable.MemoryGB, 'f', -1, 64),
+		"disk-allocatable": strconv.FormatFloat(resources.Allocatable.DiskGB, 'f', -1, 64),
+	}
+	s.updateTags()
+}
+
+func (s *SerfAgent) updateTags() {
+	tags, err := createTags(s.nodeId, s.labels, s.resources)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	err = s.agent.SetTags(tags)
+	if err != nil {
+		log.Printf("Failed to update serf tags: %v", err)
+	}
+}
+
+// createTags adds the config tags to the serf agent, node labels can't replace
+// the node id or the resources
+func createTags(nodeId string, labels, resources map[string]string) (map[string]string, error) {
+	tags := make(map[string]string, len(labels)+len(resources)+1)
+	for key, value := range labels {
+		tags[key] = value
+	}
+	for key, value := range resources {
+		tags[key] = value
+	}
+	tags["node_id"] = nodeId
+	if size := encodedTagsSize(tags); size > serfTagsMaxSize {
+		return nil, fmt.Errorf("serf tags take %d bytes encoded, at most %d fit", size, serfTagsMaxSize)
+	}
+	return tags, nil
+}
+
+// serfTagsMaxSize is the limit serf puts on the encoded tags of a member
+const serfTagsMaxSize = 512
+
+// serfLabelsMaxSize is what node labels can take of the encoded tags,
+// the rest is kept for the node id and the resource tags
+const serfLabelsMaxSize = 320
+
+// encodedTagsSize is the size of the tags as serf encodes them, a magic byte followed by
+// a msgpack map. Strings longer than 31 bytes are counted with the largest header they can get.
+func encodedTagsSize(tags map[string]string) int {
+	size := 1 + 3
+	if len(tags) < 16 {
+		size = 1 + 1
+	}
+	for key, value := range tags {
+		size += encodedStringSize(key) + encodedStringSize(value)
+	}
+	return size
+}
+
+func encodedStringSize(value string) int {
+	if len(value) < 32 {
+		return 1 + len(value)
+	}
+	return 3 + len(value)
+}
+
+// validateSerfLabels rejects node labels that don't fit into the serf tags
+func validateSerfLabels(labels map[string]string) error {
+	if size := encodedTagsSize(labels); size > serfLabelsMaxSize {
+		return fmt.Errorf("node labels take %d bytes encoded, at most %d fit into the serf tags", size, serfLabelsMaxSize)
+	}
+	return nil
 }
 
 // checkTags checks if the serf UserEvent is intended for the serf agent
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreateTagsRejectsTagsSerfCantGossip(t *testing.T) {
+	labels := map[string]string{"zone": "a", "rack": "r1"}
+	if _, err := createTags("node-1", labels, nil); err != nil {
+		t.Fatalf("small tags rejected: %v", err)
+	}
+
+	labels["description"] = strings.Repeat("x", serfTagsMaxSize)
+	if _, err := createTags("node-1", labels, nil); err == nil {
+		t.Fatal("expected an error for tags over the serf limit")
+	}
+}
+
+func TestNodeLabelsRejectOversizeLabels(t *testing.T) {
+	pairs := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		pairs = append(pairs, "label-"+strings.Repeat("k", 10)+string(rune('a'+i))+"=value")
+	}
+	_, err := NewNodeLabels(strings.Join(pairs, ","), "", "", 0)
+	if err == nil || !strings.Contains(err.Error(), "serf tags") {
+		t.Fatalf("err = %v, want an error for labels that don't fit into the serf tags", err)
+	}
+
+	labels, err := NewNodeLabels("zone=a,rack=r1", "", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels.Labels()) != 2 {
+		t.Fatalf("labels = %v", labels.Labels())
+	}
+}
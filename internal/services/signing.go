@@ -0,0 +1,26 @@
+package services
+
+import (
+	"encoding/base64"
+	"log"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/c12s/star/pkg/api"
+	"github.com/nats-io/nats.go"
+)
+
+// SignedMsg returns a message carrying the node id, the node public key and the signature of
+// the subject and data, a message that can't be signed is sent unsigned and fails verification
+func SignedMsg(signer domain.NodeSigner, nodeId, subject string, data []byte) *nats.Msg {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	msg.Header.Set(api.NodeIdHeader, nodeId)
+	msg.Header.Set(api.PublicKeyHeader, signer.PublicKey())
+	signature, err := signer.Sign(api.SignedPayload(subject, data))
+	if err != nil {
+		log.Printf("Failed to sign message on %s: %v", subject, err)
+		return msg
+	}
+	msg.Header.Set(api.SignatureHeader, base64.RawURLEncoding.EncodeToString(signature))
+	return msg
+}
@@ -1,117 +1,159 @@
 package services
 
 import (
+	"context"
 	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
 
-	"github.com/shirou/gopsutil/cpu"
 	"github.com/shirou/gopsutil/disk"
-	"github.com/shirou/gopsutil/host"
 	"github.com/shirou/gopsutil/mem"
+	"github.com/shirou/gopsutil/net"
 )
 
-func cpuCores() (float64, error) {
-	cpuCores, err := cpu.Counts(true)
-	return float64(cpuCores), err
-}
-
-func coreMhz(coreId string) (float64, error) {
-	core, err := findCoreById(coreId)
+func memoryFreeGB() (float64, error) {
+	memInfo, err := mem.VirtualMemory()
 	if err != nil {
 		return 0, err
 	}
-	return core.Mhz, nil
+	return float64(memInfo.Available / 1000000000), nil
 }
 
-func coreVendorId(coreId string) (string, error) {
-	core, err := findCoreById(coreId)
-	if err != nil {
-		return "", err
-	}
-	return core.VendorID, nil
+type networkInterface struct {
+	name      string
+	mac       string
+	speedMbps float64
+	ips       []string
 }
 
-func coreModel(coreId string) (string, error) {
-	core, err := findCoreById(coreId)
+// virtualInterfacePrefixes name the interfaces container runtimes create and remove with their containers
+var virtualInterfacePrefixes = []string{"veth", "docker", "br-", "cni", "flannel", "cali", "virbr"}
+
+// networkInterfaces leaves out loopback and virtual interfaces, the speed is only known on linux
+func networkInterfaces(ctx context.Context) ([]networkInterface, error) {
+	stats, err := net.InterfacesWithContext(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	interfaces := make([]networkInterface, 0, len(stats))
+	for _, stat := range stats {
+		if slices.Contains(stat.Flags, "loopback") || virtualInterface(stat.Name) {
+			continue
+		}
+		iface := networkInterface{
+			name: stat.Name,
+			mac:  stat.HardwareAddr,
+			ips:  make([]string, 0, len(stat.Addrs)),
+		}
+		for _, addr := range stat.Addrs {
+			ip, _, _ := strings.Cut(addr.Addr, "/")
+			iface.ips = append(iface.ips, ip)
+		}
+		// virtual interfaces report -1 or fail to report a speed at all
+		if speed, err := readSysFloat(filepath.Join("/sys/class/net", stat.Name, "speed")); err == nil && speed > 0 {
+			iface.speedMbps = speed
+		}
+		interfaces = append(interfaces, iface)
 	}
-	return core.ModelName, nil
+	return interfaces, nil
 }
 
-func coreCacheKB(coreId string) (float64, error) {
-	core, err := findCoreById(coreId)
-	if err != nil {
-		return 0, err
+// virtualInterface reports interfaces without a device behind them, on linux only physical
+// ones have /sys/class/net/<name>/device. Elsewhere they are told apart by their name.
+func virtualInterface(name string) bool {
+	if _, err := os.Stat("/sys/class/net"); err == nil {
+		_, err := os.Stat(filepath.Join("/sys/class/net", name, "device"))
+		return err != nil
 	}
-	return float64(core.CacheSize / 1000), nil
+	for _, prefix := range virtualInterfacePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type mountedFilesystem struct {
+	device  string
+	path    string
+	fsType  string
+	totalGB float64
+	freeGB  float64
 }
 
-func findCoreById(coreId string) (cpu.InfoStat, error) {
-	cores, err := cpu.Info()
+// mountedFilesystems returns physical filesystems only, skipping proc, sysfs, cgroups and the like
+func mountedFilesystems(ctx context.Context) ([]mountedFilesystem, error) {
+	partitions, err := disk.PartitionsWithContext(ctx, false)
 	if err != nil {
-		return cpu.InfoStat{}, err
+		return nil, err
 	}
-	for _, core := range cores {
-		if core.CoreID == coreId {
-			return core, nil
+	filesystems := make([]mountedFilesystem, 0, len(partitions))
+	for _, partition := range partitions {
+		usage, err := disk.UsageWithContext(ctx, partition.Mountpoint)
+		if err != nil {
+			continue
 		}
+		filesystems = append(filesystems, mountedFilesystem{
+			device:  partition.Device,
+			path:    partition.Mountpoint,
+			fsType:  partition.Fstype,
+			totalGB: float64(usage.Total / 1000000000),
+			freeGB:  float64(usage.Free / 1000000000),
+		})
 	}
-	return cpu.InfoStat{}, errors.New("core not found")
+	return filesystems, nil
 }
 
-func fsType() (string, error) {
-	diskInfo, err := disk.Usage("/")
-	if err != nil {
-		return "", err
-	} else if diskInfo.Fstype == "" {
-		return "", errors.New("fs-type undefined")
-	}
-	return diskInfo.Fstype, nil
+type blockDevice struct {
+	name       string
+	sizeGB     float64
+	rotational bool
 }
 
-func diskTotalGB() (float64, error) {
-	diskInfo, err := disk.Usage("/")
+// blockDevices reads /sys/block, so it only reports devices on linux, loop and ram devices are left out
+func blockDevices() ([]blockDevice, error) {
+	entries, err := os.ReadDir("/sys/block")
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return float64(diskInfo.Total / 1000000000), nil
+	devices := make([]blockDevice, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram") {
+			continue
+		}
+		device := blockDevice{name: name}
+		// the size is always given in 512 byte sectors
+		if sectors, err := readSysFloat(filepath.Join("/sys/block", name, "size")); err == nil {
+			device.sizeGB = math.Floor(sectors * 512 / 1000000000)
+		}
+		if rotational, err := readSysFloat(filepath.Join("/sys/block", name, "queue", "rotational")); err == nil {
+			device.rotational = rotational == 1
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
 }
 
-func diskFreeGB() (float64, error) {
-	diskInfo, err := disk.Usage("/")
+func readSysFloat(path string) (float64, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return 0, err
 	}
-	return float64(diskInfo.Free / 1000000000), nil
-}
-
-func kernelArch() (string, error) {
-	return host.KernelArch()
-}
-
-func kernelVersion() (string, error) {
-	return host.KernelVersion()
-}
-
-func platform() (string, error) {
-	platform, _, _, err := host.PlatformInformation()
-	return platform, err
-}
-
-func platformFamily() (string, error) {
-	_, platformFamily, _, err := host.PlatformInformation()
-	return platformFamily, err
+	return strconv.ParseFloat(strings.TrimSpace(string(data)), 64)
 }
 
-func platformVersion() (string, error) {
-	_, _, platformVersion, err := host.PlatformInformation()
-	return platformVersion, err
-}
-
-func memoryTotalGB() (float64, error) {
-	memInfo, err := mem.VirtualMemory()
+func numaNodes() (float64, error) {
+	nodes, err := filepath.Glob("/sys/devices/system/node/node[0-9]*")
 	if err != nil {
 		return 0, err
 	}
-	return float64(memInfo.Total / 1000000000), nil
+	if len(nodes) == 0 {
+		return 0, errors.New("numa topology unavailable")
+	}
+	return float64(len(nodes)), nil
 }
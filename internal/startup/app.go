@@ -1,14 +1,17 @@
 package startup
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net"
+	"time"
 
 	kuiperapi "github.com/c12s/kuiper/pkg/api"
-	magnetarapi "github.com/c12s/magnetar/pkg/api"
 	meridianapi "github.com/c12s/meridian/pkg/api"
 	"github.com/c12s/star/internal/configs"
+	"github.com/c12s/star/internal/domain"
 	"github.com/c12s/star/internal/servers"
 	"github.com/c12s/star/internal/services"
 	"github.com/c12s/star/internal/store"
@@ -28,6 +31,15 @@ type app struct {
 	serfAgent               *services.SerfAgent
 	clusterJoinListener     *services.ClusterJoinListener
 	appOperationAsyncServer *servers.AppOperationAsyncServer
+	appEventWatcher         *services.AppEventWatcher
+	appGarbageCollector     *services.AppGarbageCollector
+	appSupervisor           *services.AppSupervisor
+	appIndex                *services.AppIndex
+	operationExecutor       *services.OperationExecutor
+	heartbeat               *services.HeartbeatService
+	nodeLabels              *services.NodeLabels
+	decommissioner          *servers.NodeDecommissioner
+	decommissioned          chan struct{}
 }
 
 func NewAppWithConfig(config *configs.Config) (*app, error) {
@@ -37,6 +49,7 @@ func NewAppWithConfig(config *configs.Config) (*app, error) {
 	return &app{
 		config:            config,
 		shutdownProcesses: make([]func(), 0),
+		decommissioned:    make(chan struct{}),
 	}, nil
 }
 
@@ -50,28 +63,65 @@ func (a *app) init() {
 		natsConn.Close()
 	})
 
-	dockerClient, err := client.NewClientWithOpts(client.WithHost(a.config.DockerClientAddress()), client.WithAPIVersionNegotiation())
+	appRuntime, err := a.newAppRuntime()
 	if err != nil {
 		log.Fatalln(err)
 	}
-	a.shutdownProcesses = append(a.shutdownProcesses, func() {
-		log.Println("closing docker client")
-		dockerClient.Close()
-	})
 
 	nodeIdStore, err := store.NewNodeIdFSStore(a.config.NodeIdDirPath(), a.config.NodeIdFileName())
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	registrationClient, err := magnetarapi.NewRegistrationAsyncClient(a.config.NatsAddress())
+	nodeKeyStore, err := store.NewNodeKeyFSStore(a.config.NodeIdDirPath())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	nodeSigner, err := nodeKeyStore.Load()
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	registrationService := services.NewRegistrationService(registrationClient, nodeIdStore)
+	registrationTimeout := time.Duration(a.config.RegistrationReqTimeoutMilliseconds()) * time.Millisecond
+	nodeLabelsReload := time.Duration(a.config.NodeLabelsReloadSeconds()) * time.Second
+	a.nodeLabels, err = services.NewNodeLabels(a.config.NodeLabels(), a.config.NodeLabelsFilePath(), a.config.NodeLabelsDirPath(), nodeLabelsReload)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	labelProviderTimeout := time.Duration(a.config.LabelProviderTimeoutMilliseconds()) * time.Millisecond
+	labelProviders := services.NewLabelProviderRegistry(labelProviderTimeout)
+	err = services.RegisterNodeLabelProviders(labelProviders, appRuntime, nodeSigner, a.nodeLabels, a.config.DataVolumePath())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	systemReserved := domain.Resources{
+		CPU:      a.config.SystemReservedCPU(),
+		MemoryGB: a.config.SystemReservedMemoryGB(),
+		DiskGB:   a.config.SystemReservedDiskGB(),
+	}
+	if !systemReserved.Valid() {
+		log.Fatalf("invalid system reserved resources: %+v", systemReserved)
+	}
+	indexResyncInterval := time.Duration(a.config.AppIndexResyncSeconds()) * time.Second
+	a.appIndex = services.NewAppIndex(appRuntime, indexResyncInterval)
+	resourceTracker := services.NewResourceTracker(a.appIndex, a.config.DataVolumePath(), systemReserved)
+	namespaceQuotas, err := services.ReadNamespaceQuotas(a.config.NamespaceQuotasFilePath())
+	if err != nil {
+		log.Fatalln(err)
+	}
+	appAdmission := services.NewAppAdmission(resourceTracker, namespaceQuotas)
+
+	registrationService := services.NewRegistrationService(natsConn, nodeIdStore, labelProviders, resourceTracker, registrationTimeout)
 	if !registrationService.Registered() {
-		err := registrationService.Register(a.config.MaxRegistrationRetries(), a.config.SerfBindAddress())
+		// filled early so the registration reports what the apps reserve,
+		// startAppIndex fills it again once app events are watched
+		err := a.appIndex.Resync(context.Background())
+		if err != nil {
+			log.Fatalln(err)
+		}
+		err = registrationService.Register(context.Background(), a.config.MaxRegistrationRetries(), a.config.SerfBindAddress())
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -87,19 +137,24 @@ func (a *app) init() {
 		log.Fatalln(err)
 	}
 
-	agent, err := services.NewSerfAgent(a.config, natsConn, nodeId.Value, configStore)
+	auditStore, err := store.NewAuditFSStore(a.config.AuditDirPath(), a.config.AuditMaxFileBytes(), a.config.AuditMaxFiles())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	agent, err := services.NewSerfAgent(a.config, natsConn, nodeId.Value, configStore, a.nodeLabels.Labels())
 	if err != nil {
 		log.Fatalln(err)
 	}
 	a.serfAgent = agent
 
-	a.clusterJoinListener = services.NewClusterJoinListener(natsConn, a.serfAgent, nodeId.Value, nodeIdStore)
+	a.clusterJoinListener = services.NewClusterJoinListener(natsConn, a.serfAgent, nodeId.Value, nodeIdStore, nodeSigner)
 
 	configClient, err := kuiperapi.NewKuiperAsyncClient(a.config.NatsAddress(), nodeId.Value)
 	if err != nil {
 		log.Fatalln(err)
 	}
-	configAsyncServer, err := servers.NewConfigAsyncServer(configClient, configStore, agent, nodeId.Value)
+	configAsyncServer, err := servers.NewConfigAsyncServer(configClient, configStore, agent, auditStore, natsConn, nodeSigner, nodeId.Value)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -109,7 +164,7 @@ func (a *app) init() {
 	if err != nil {
 		log.Fatalln(err)
 	}
-	appConfigAsyncServer, err := servers.NewAppConfigAsyncServer(meridianClient, agent, nodeId.Value)
+	appConfigAsyncServer, err := servers.NewAppConfigAsyncServer(meridianClient, agent, auditStore, natsConn, nodeSigner, nodeId.Value)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -120,23 +175,106 @@ func (a *app) init() {
 	if err != nil {
 		log.Fatalln(err)
 	}
-	appOperationAsyncServer, err := servers.NewAppOperationAsyncServer(rusClient, dockerClient, nodeId.Value)
+	gcInterval := time.Duration(a.config.AppGCIntervalSeconds()) * time.Second
+	gcRetention := time.Duration(a.config.AppGCRetentionSeconds()) * time.Second
+
+	imageDefaults := domain.ImageDefaults{
+		Image:      a.config.DefaultAppImage(),
+		PullPolicy: domain.ImagePullPolicy(a.config.ImagePullPolicy()),
+	}
+	if !imageDefaults.PullPolicy.Valid() {
+		log.Fatalf("invalid image pull policy: %s", imageDefaults.PullPolicy)
+	}
+
+	operationTimeout := time.Duration(a.config.AppOperationTimeoutSeconds()) * time.Second
+	a.operationExecutor = services.NewOperationExecutor(a.config.AppOperationWorkers(), a.config.AppOperationQueueSize(), operationTimeout)
+
+	a.appSupervisor = services.NewAppSupervisor(appRuntime, a.operationExecutor, appAdmission)
+	a.appGarbageCollector = services.NewAppGarbageCollector(appRuntime, a.operationExecutor, a.appSupervisor, natsConn, nodeId.Value, gcInterval, gcRetention)
+
+	appOperationAsyncServer, err := servers.NewAppOperationAsyncServer(rusClient, natsConn, nodeSigner, appRuntime, imageDefaults, a.appGarbageCollector, a.appIndex, a.appSupervisor, a.operationExecutor, appAdmission, auditStore, nodeId.Value)
 	if err != nil {
 		log.Fatalln(err)
 	}
 	a.appOperationAsyncServer = appOperationAsyncServer
 
+	heartbeatInterval := time.Duration(a.config.HeartbeatIntervalSeconds()) * time.Second
+	a.heartbeat = services.NewHeartbeatService(natsConn, registrationService, nodeSigner, nodeIdStore, a.appIndex, a.config.SerfBindAddress(), a.config.MaxRegistrationRetries(), heartbeatInterval, registrationTimeout, func(nodeId string) {
+		// star keeps running rather than exit, a responder wrongly denying the node mustn't crash loop it
+		log.Printf("Node registered again as %s, requests are served under the previous id until star is restarted", nodeId)
+	})
+
+	// labels are re-sent to magnetar with a heartbeat and gossiped with the serf tags
+	a.nodeLabels.AddListener(func(map[string]string) {
+		a.heartbeat.Trigger()
+	})
+	a.nodeLabels.AddListener(a.serfAgent.SetLabels)
+	// the resources are sent with every heartbeat, one is sent right away when apps come and go
+	resourceTracker.AddListener(a.serfAgent.SetResources)
+
+	a.appEventWatcher = services.NewAppEventWatcher(appRuntime, natsConn, nodeId.Value)
+	a.appEventWatcher.AddListener(a.appSupervisor.OnEvent)
+	a.appEventWatcher.AddListener(a.appIndex.OnEvent)
+	a.appEventWatcher.AddListener(func(event domain.AppEvent) {
+		switch event.Type {
+		case domain.AppEventStarted, domain.AppEventDied, domain.AppEventRemoved:
+			a.heartbeat.Trigger()
+		}
+	})
+
 	configGrpcServer, err := servers.NewStarConfigServer(configStore)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
+	a.decommissioner = servers.NewNodeDecommissioner(natsConn, appOperationAsyncServer, a.serfAgent, a.heartbeat, nodeIdStore, nodeKeyStore, nodeSigner, auditStore, nodeId.Value, registrationTimeout, func() {
+		close(a.decommissioned)
+	})
+
+	nodeGrpcServer, err := servers.NewStarNodeServer(a.operationExecutor, auditStore, a.decommissioner, resourceTracker)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
 	s := grpc.NewServer()
 	api.RegisterStarConfigServer(s, configGrpcServer)
+	api.RegisterStarNodeServer(s, nodeGrpcServer)
 	reflection.Register(s)
 	a.grpcServer = s
 }
 
+// newAppRuntime returns the runtime selected by the APP_RUNTIME config, docker or process
+func (a *app) newAppRuntime() (domain.AppRuntime, error) {
+	switch a.config.AppRuntime() {
+	case "docker":
+		dockerClient, err := client.NewClientWithOpts(client.WithHost(a.config.DockerClientAddress()), client.WithAPIVersionNegotiation())
+		if err != nil {
+			return nil, err
+		}
+		a.shutdownProcesses = append(a.shutdownProcesses, func() {
+			log.Println("closing docker client")
+			dockerClient.Close()
+		})
+		imagePuller, err := services.NewImagePuller(dockerClient, a.config.RegistryAuthFilePath(), a.config.RegistryServer(), a.config.RegistryUsername(), a.config.RegistryPassword(), time.Duration(a.config.ImagePullTimeoutSeconds())*time.Second)
+		if err != nil {
+			return nil, err
+		}
+		return services.NewDockerRuntime(dockerClient, imagePuller), nil
+	case "process":
+		processRuntime, err := services.NewProcessRuntime(a.config.ProcessRuntimeDirPath())
+		if err != nil {
+			return nil, err
+		}
+		// unlike containers, app processes don't outlive star
+		a.shutdownProcesses = append(a.shutdownProcesses, func() {
+			log.Println("stopping app processes")
+			processRuntime.StopAll(context.Background())
+		})
+		return processRuntime, nil
+	}
+	return nil, fmt.Errorf("unknown app runtime: %s", a.config.AppRuntime())
+}
+
 func (a *app) startSerfAgent() error {
 	// todo: join on signal
 	// err := a.serfAgent.Join(true)
@@ -151,9 +289,50 @@ func (a *app) startSerfAgent() error {
 	return nil
 }
 
+func (a *app) startAppEventWatcher() error {
+	a.appEventWatcher.Wg.Add(1)
+	go a.appEventWatcher.Watch()
+	return nil
+}
+
+// startAppIndex fills the index once the event watcher is running, so no change is missed in between,
+// the apps star supervised before it restarted are supervised again from it
+func (a *app) startAppIndex() error {
+	err := a.appIndex.Resync(context.Background())
+	if err != nil {
+		return err
+	}
+	a.appSupervisor.Restore(a.appIndex.All())
+	a.appIndex.Wg.Add(1)
+	go a.appIndex.Run()
+	return nil
+}
+
+func (a *app) startHeartbeat() error {
+	a.heartbeat.Wg.Add(1)
+	go a.heartbeat.Run()
+	// the first heartbeat brings the reserved resources and the serf tags up to date
+	a.heartbeat.Trigger()
+	a.nodeLabels.Wg.Add(1)
+	go a.nodeLabels.Watch()
+	return nil
+}
+
+// Decommissioned is closed once the node was decommissioned, star should stop then
+func (a *app) Decommissioned() <-chan struct{} {
+	return a.decommissioned
+}
+
+func (a *app) startAppGarbageCollector() error {
+	a.appGarbageCollector.Wg.Add(1)
+	go a.appGarbageCollector.Run()
+	return nil
+}
+
 func (a *app) startConfigAsyncServer() error {
 	a.configAsyncServer.Serve()
 	a.appConfigAsyncServer.Serve()
+	a.operationExecutor.Start()
 	a.appOperationAsyncServer.Serve()
 	return nil
 }
@@ -175,7 +354,15 @@ func (a *app) startGrpcServer() error {
 func (a *app) Start() error {
 	a.init()
 
-	err := a.startConfigAsyncServer()
+	err := a.startAppEventWatcher()
+	if err != nil {
+		return err
+	}
+	err = a.startAppIndex()
+	if err != nil {
+		return err
+	}
+	err = a.startConfigAsyncServer()
 	if err != nil {
 		return err
 	}
@@ -187,13 +374,31 @@ func (a *app) Start() error {
 	if err != nil {
 		return err
 	}
+	err = a.startAppGarbageCollector()
+	if err != nil {
+		return err
+	}
+	err = a.startHeartbeat()
+	if err != nil {
+		return err
+	}
 	a.clusterJoinListener.Listen()
+	a.decommissioner.Serve()
 	return nil
 }
 
 func (a *app) GracefulStop() {
 	go a.configAsyncServer.GracefulStop()
+	a.appOperationAsyncServer.GracefulStop()
+	// the collector waits for the removals it queued, so it is stopped while the executor runs them
+	a.appGarbageCollector.Stop()
+	a.operationExecutor.Stop()
 	a.grpcServer.GracefulStop()
+	a.nodeLabels.Stop()
+	a.heartbeat.Stop()
+	a.appEventWatcher.Stop()
+	a.appSupervisor.Stop()
+	a.appIndex.Stop()
 	a.serfAgent.Leave()
 	for _, shudownProcess := range a.shutdownProcesses {
 		shudownProcess()
@@ -0,0 +1,201 @@
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/c12s/star/internal/domain"
+)
+
+const (
+	auditFileName = "audit.log"
+	// a single entry can't be longer than this, the request is part of it
+	maxAuditEntryBytes = 1024 * 1024
+)
+
+// auditFSStore appends entries as JSON lines, when the current file would grow over
+// maxFileBytes it is rotated to audit.log.1, audit.log.1 to audit.log.2 and so on,
+// keeping at most maxFiles rotated files
+type auditFSStore struct {
+	filePath     string
+	maxFileBytes int64
+	maxFiles     int
+	file         *os.File
+	size         int64
+	lock         sync.Mutex
+}
+
+func NewAuditFSStore(dirPath string, maxFileBytes int64, maxFiles int) (domain.AuditStore, error) {
+	err := os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return nil, err
+	}
+	store := &auditFSStore{
+		filePath:     filepath.Join(dirPath, auditFileName),
+		maxFileBytes: maxFileBytes,
+		maxFiles:     maxFiles,
+	}
+	err = store.open()
+	if err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *auditFSStore) open() error {
+	file, err := os.OpenFile(s.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *auditFSStore) Append(entry domain.AuditEntry) *domain.Error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeMarshalSS, err.Error())
+	}
+	data = append(data, '\n')
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.size > 0 && s.size+int64(len(data)) > s.maxFileBytes {
+		err = s.rotate()
+		if err != nil {
+			return domain.NewError(domain.ErrTypeDb, fmt.Sprintf("rotating audit log: %s", err))
+		}
+	}
+	n, err := s.file.Write(data)
+	s.size += int64(n)
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	err = s.file.Sync()
+	if err != nil {
+		return domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	return nil
+}
+
+// rotate must be called with the lock held
+func (s *auditFSStore) rotate() error {
+	err := s.file.Close()
+	if err != nil {
+		return err
+	}
+	err = os.Remove(s.rotatedPath(s.maxFiles))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	for i := s.maxFiles - 1; i >= 1; i-- {
+		err = os.Rename(s.rotatedPath(i), s.rotatedPath(i+1))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	if s.maxFiles > 0 {
+		err = os.Rename(s.filePath, s.rotatedPath(1))
+	} else {
+		err = os.Remove(s.filePath)
+	}
+	if err != nil {
+		return err
+	}
+	return s.open()
+}
+
+func (s *auditFSStore) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.filePath, i)
+}
+
+// auditFileSnapshot is an audit file opened for a query and the size it had then,
+// an open file keeps its content when it is rotated away
+type auditFileSnapshot struct {
+	path string
+	file *os.File
+	size int64
+}
+
+// Query reads the files opened under the lock after releasing it, so appends aren't held up
+func (s *auditFSStore) Query(query domain.AuditQuery) ([]domain.AuditEntry, *domain.Error) {
+	snapshots, err := s.snapshot()
+	defer func() {
+		for _, snapshot := range snapshots {
+			snapshot.file.Close()
+		}
+	}()
+	if err != nil {
+		return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+	}
+	entries := make([]domain.AuditEntry, 0)
+	for _, snapshot := range snapshots {
+		err := readAuditFile(snapshot.path, io.LimitReader(snapshot.file, snapshot.size), query, &entries)
+		if err != nil {
+			return nil, domain.NewError(domain.ErrTypeDb, err.Error())
+		}
+		if query.Limit > 0 && len(entries) > query.Limit {
+			entries = entries[len(entries)-query.Limit:]
+		}
+	}
+	return entries, nil
+}
+
+// snapshot opens the oldest file first, so entries come out in the order they were appended
+func (s *auditFSStore) snapshot() ([]auditFileSnapshot, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	snapshots := make([]auditFileSnapshot, 0, s.maxFiles+1)
+	for i := s.maxFiles; i >= 0; i-- {
+		path := s.filePath
+		if i > 0 {
+			path = s.rotatedPath(i)
+		}
+		file, err := os.Open(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return snapshots, err
+		}
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return snapshots, err
+		}
+		snapshots = append(snapshots, auditFileSnapshot{path: path, file: file, size: info.Size()})
+	}
+	return snapshots, nil
+}
+
+func readAuditFile(path string, reader io.Reader, query domain.AuditQuery, entries *[]domain.AuditEntry) error {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxAuditEntryBytes)
+	for scanner.Scan() {
+		entry := domain.AuditEntry{}
+		err := json.Unmarshal(scanner.Bytes(), &entry)
+		if err != nil {
+			// the last line can be cut short if star was killed while appending it
+			log.Printf("Skipping invalid audit entry in %s: %v", path, err)
+			continue
+		}
+		if query.Matches(entry) {
+			*entries = append(*entries, entry)
+		}
+	}
+	return scanner.Err()
+}
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 
@@ -42,3 +44,13 @@ func (n nodeIdFSStore) Put(nodeId domain.NodeId) error {
 func (n nodeIdFSStore) PutClusterId(clusterId string) error {
 	return os.WriteFile(n.clusterFilePath, []byte(clusterId), 0666)
 }
+
+func (n nodeIdFSStore) Delete() error {
+	for _, path := range []string{n.filePath, n.clusterFilePath} {
+		err := os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/c12s/star/internal/domain"
+	"github.com/nats-io/nkeys"
+)
+
+const nodeKeyFileName = "node.nk"
+
+type nodeKeyFSStore struct {
+	filePath string
+}
+
+func NewNodeKeyFSStore(dirPath string) (domain.NodeKeyStore, error) {
+	return &nodeKeyFSStore{
+		filePath: dirPath + string(filepath.Separator) + nodeKeyFileName,
+	}, nil
+}
+
+// Load reads the seed of the keypair, the seed file is only readable by the owner
+func (n nodeKeyFSStore) Load() (domain.NodeSigner, error) {
+	seed, err := os.ReadFile(n.filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return n.create()
+	}
+	if err != nil {
+		return nil, err
+	}
+	keyPair, err := nkeys.FromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	return newNodeKeySigner(keyPair)
+}
+
+func (n nodeKeyFSStore) create() (domain.NodeSigner, error) {
+	// server keys are the nkeys type for nodes, their public keys start with N
+	keyPair, err := nkeys.CreateServer()
+	if err != nil {
+		return nil, err
+	}
+	seed, err := keyPair.Seed()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(filepath.Dir(n.filePath), 0700)
+	if err != nil {
+		return nil, err
+	}
+	// O_EXCL keeps a seed written by a concurrent start from being overwritten
+	file, err := os.OpenFile(n.filePath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	_, err = file.Write(seed)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(n.filePath)
+		return nil, err
+	}
+	return newNodeKeySigner(keyPair)
+}
+
+func (n nodeKeyFSStore) Delete() error {
+	err := os.Remove(n.filePath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+type nodeKeySigner struct {
+	keyPair   nkeys.KeyPair
+	publicKey string
+}
+
+func newNodeKeySigner(keyPair nkeys.KeyPair) (domain.NodeSigner, error) {
+	publicKey, err := keyPair.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	return &nodeKeySigner{
+		keyPair:   keyPair,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (s nodeKeySigner) PublicKey() string {
+	return s.publicKey
+}
+
+func (s nodeKeySigner) Sign(data []byte) ([]byte, error) {
+	return s.keyPair.Sign(data)
+}
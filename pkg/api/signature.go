@@ -0,0 +1,43 @@
+package api
+
+import (
+	"encoding/base64"
+	"errors"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+)
+
+// Headers of the messages star signs: join acks, config acks, operation responses,
+// decommission responses, heartbeats and deregistration requests. The public key is
+// the one star sends with its registration, as the node-public-key label.
+const (
+	NodeIdHeader    = "Star-Node-Id"
+	PublicKeyHeader = "Star-Public-Key"
+	SignatureHeader = "Star-Signature"
+)
+
+// SignedPayload returns what the signature covers, the subject is included
+// so a signed message can't be replayed on another subject
+func SignedPayload(subject string, data []byte) []byte {
+	payload := make([]byte, 0, len(subject)+1+len(data))
+	payload = append(payload, subject...)
+	payload = append(payload, '\n')
+	return append(payload, data...)
+}
+
+// VerifyNodeSignature checks that msg was signed by the node with the given public key
+func VerifyNodeSignature(msg *nats.Msg, publicKey string) error {
+	if msg.Header.Get(PublicKeyHeader) != publicKey {
+		return errors.New("message is not signed with the node key")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(msg.Header.Get(SignatureHeader))
+	if err != nil {
+		return err
+	}
+	keyPair, err := nkeys.FromPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	return keyPair.Verify(SignedPayload(msg.Subject, msg.Data), signature)
+}
@@ -139,3 +139,197 @@ var StarConfig_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "star.proto",
 }
+
+// StarNodeClient is the client API for StarNode service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StarNodeClient interface {
+	GetOperationQueueStats(ctx context.Context, in *GetOperationQueueStatsReq, opts ...grpc.CallOption) (*GetOperationQueueStatsResp, error)
+	QueryAuditLog(ctx context.Context, in *QueryAuditLogReq, opts ...grpc.CallOption) (*QueryAuditLogResp, error)
+	Decommission(ctx context.Context, in *DecommissionReq, opts ...grpc.CallOption) (*DecommissionResp, error)
+	GetNodeResources(ctx context.Context, in *GetNodeResourcesReq, opts ...grpc.CallOption) (*GetNodeResourcesResp, error)
+}
+
+type starNodeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStarNodeClient(cc grpc.ClientConnInterface) StarNodeClient {
+	return &starNodeClient{cc}
+}
+
+func (c *starNodeClient) GetOperationQueueStats(ctx context.Context, in *GetOperationQueueStatsReq, opts ...grpc.CallOption) (*GetOperationQueueStatsResp, error) {
+	out := new(GetOperationQueueStatsResp)
+	err := c.cc.Invoke(ctx, "/proto.StarNode/GetOperationQueueStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *starNodeClient) QueryAuditLog(ctx context.Context, in *QueryAuditLogReq, opts ...grpc.CallOption) (*QueryAuditLogResp, error) {
+	out := new(QueryAuditLogResp)
+	err := c.cc.Invoke(ctx, "/proto.StarNode/QueryAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *starNodeClient) Decommission(ctx context.Context, in *DecommissionReq, opts ...grpc.CallOption) (*DecommissionResp, error) {
+	out := new(DecommissionResp)
+	err := c.cc.Invoke(ctx, "/proto.StarNode/Decommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *starNodeClient) GetNodeResources(ctx context.Context, in *GetNodeResourcesReq, opts ...grpc.CallOption) (*GetNodeResourcesResp, error) {
+	out := new(GetNodeResourcesResp)
+	err := c.cc.Invoke(ctx, "/proto.StarNode/GetNodeResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StarNodeServer is the server API for StarNode service.
+// All implementations must embed UnimplementedStarNodeServer
+// for forward compatibility
+type StarNodeServer interface {
+	GetOperationQueueStats(context.Context, *GetOperationQueueStatsReq) (*GetOperationQueueStatsResp, error)
+	QueryAuditLog(context.Context, *QueryAuditLogReq) (*QueryAuditLogResp, error)
+	Decommission(context.Context, *DecommissionReq) (*DecommissionResp, error)
+	GetNodeResources(context.Context, *GetNodeResourcesReq) (*GetNodeResourcesResp, error)
+	mustEmbedUnimplementedStarNodeServer()
+}
+
+// UnimplementedStarNodeServer must be embedded to have forward compatible implementations.
+type UnimplementedStarNodeServer struct {
+}
+
+func (UnimplementedStarNodeServer) GetOperationQueueStats(context.Context, *GetOperationQueueStatsReq) (*GetOperationQueueStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperationQueueStats not implemented")
+}
+func (UnimplementedStarNodeServer) QueryAuditLog(context.Context, *QueryAuditLogReq) (*QueryAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAuditLog not implemented")
+}
+func (UnimplementedStarNodeServer) Decommission(context.Context, *DecommissionReq) (*DecommissionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decommission not implemented")
+}
+func (UnimplementedStarNodeServer) GetNodeResources(context.Context, *GetNodeResourcesReq) (*GetNodeResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeResources not implemented")
+}
+func (UnimplementedStarNodeServer) mustEmbedUnimplementedStarNodeServer() {}
+
+// UnsafeStarNodeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StarNodeServer will
+// result in compilation errors.
+type UnsafeStarNodeServer interface {
+	mustEmbedUnimplementedStarNodeServer()
+}
+
+func RegisterStarNodeServer(s grpc.ServiceRegistrar, srv StarNodeServer) {
+	s.RegisterService(&StarNode_ServiceDesc, srv)
+}
+
+func _StarNode_GetOperationQueueStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationQueueStatsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarNodeServer).GetOperationQueueStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StarNode/GetOperationQueueStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarNodeServer).GetOperationQueueStats(ctx, req.(*GetOperationQueueStatsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StarNode_QueryAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarNodeServer).QueryAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StarNode/QueryAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarNodeServer).QueryAuditLog(ctx, req.(*QueryAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StarNode_Decommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarNodeServer).Decommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StarNode/Decommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarNodeServer).Decommission(ctx, req.(*DecommissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StarNode_GetNodeResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarNodeServer).GetNodeResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.StarNode/GetNodeResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarNodeServer).GetNodeResources(ctx, req.(*GetNodeResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StarNode_ServiceDesc is the grpc.ServiceDesc for StarNode service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StarNode_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.StarNode",
+	HandlerType: (*StarNodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOperationQueueStats",
+			Handler:    _StarNode_GetOperationQueueStats_Handler,
+		},
+		{
+			MethodName: "QueryAuditLog",
+			Handler:    _StarNode_QueryAuditLog_Handler,
+		},
+		{
+			MethodName: "Decommission",
+			Handler:    _StarNode_Decommission_Handler,
+		},
+		{
+			MethodName: "GetNodeResources",
+			Handler:    _StarNode_GetNodeResources_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "star.proto",
+}